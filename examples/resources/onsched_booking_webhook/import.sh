@@ -0,0 +1,2 @@
+# The booking webhook is imported by the ID of the company it belongs to.
+terraform import onsched_booking_webhook.booking <company-id>
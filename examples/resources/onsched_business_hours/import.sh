@@ -0,0 +1,2 @@
+# Business hours are imported by the ID of their location.
+terraform import onsched_business_hours.downtown <location-id>
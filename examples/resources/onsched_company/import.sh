@@ -0,0 +1,2 @@
+# The company is imported by its ID, which must match the company of the provider credentials.
+terraform import onsched_company.company <company-id>
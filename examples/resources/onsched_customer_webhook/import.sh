@@ -0,0 +1,2 @@
+# The customer webhook is imported by the ID of the company it belongs to.
+terraform import onsched_customer_webhook.customer <company-id>
@@ -0,0 +1,2 @@
+# Holidays are imported by the ID of their location and the holiday ID.
+terraform import onsched_holiday.canada_day <location-id>/<holiday-id>
@@ -0,0 +1,2 @@
+# Locations are imported by their ID.
+terraform import onsched_location.downtown <location-id>
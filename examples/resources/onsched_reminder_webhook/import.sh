@@ -0,0 +1,2 @@
+# The reminder webhook is imported by the ID of the company it belongs to.
+terraform import onsched_reminder_webhook.reminder <company-id>
@@ -0,0 +1,2 @@
+# Resources are imported by their ID.
+terraform import onsched_resource.jane <resource-id>
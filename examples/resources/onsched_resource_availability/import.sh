@@ -0,0 +1,2 @@
+# Resource availability is imported by the ID of its resource.
+terraform import onsched_resource_availability.jane <resource-id>
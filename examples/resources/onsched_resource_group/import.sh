@@ -0,0 +1,3 @@
+# Resource groups are imported by their ID. Membership is not imported; set
+# resource_ids to start managing it.
+terraform import onsched_resource_group.therapists <resource-group-id>
@@ -0,0 +1,2 @@
+# The resource webhook is imported by the ID of the company it belongs to.
+terraform import onsched_resource_webhook.resource <company-id>
@@ -0,0 +1,2 @@
+# Services are imported by their ID.
+terraform import onsched_service.massage <service-id>
@@ -0,0 +1,2 @@
+# Service allocations are imported by the ID of their service and the allocation ID.
+terraform import onsched_service_allocation.yoga <service-id>/<allocation-id>
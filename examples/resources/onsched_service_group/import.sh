@@ -0,0 +1,2 @@
+# Service groups are imported by their ID.
+terraform import onsched_service_group.massage <service-group-id>
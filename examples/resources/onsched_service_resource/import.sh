@@ -0,0 +1,2 @@
+# Links are imported by the service ID and the resource ID.
+terraform import onsched_service_resource.jane_massage <service-id>/<resource-id>
@@ -0,0 +1,2 @@
+# Webhooks are imported by the ID of the company they belong to.
+terraform import onsched_webhook.webhooks <company-id>
@@ -0,0 +1,471 @@
+// Package onschedtest provides an in-process stand-in for the OnSched API and
+// identity server, so the client and provider can be tested offline.
+package onschedtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-onsched/onsched"
+)
+
+const (
+	ClientID     = "test-client"
+	ClientSecret = "test-secret"
+
+	accessToken = "test-access-token"
+)
+
+// Server is a fake OnSched API. It keeps its objects in memory and is safe
+// for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	company        onsched.Company
+	locations      *store[onsched.Location]
+	services       *store[onsched.Service]
+	resources      *store[onsched.Resource]
+	holidays       *store[onsched.Holiday]
+	groups         *store[onsched.ServiceGroup]
+	resourceGroups *store[onsched.ResourceGroup]
+	allocations    *store[onsched.ServiceAllocation]
+	// members and serviceResources hold the IDs of the resources linked to
+	// each resource group and service.
+	members          links
+	serviceResources links
+}
+
+// links maps an object ID to the IDs of the resources linked to it.
+type links map[string]map[string]bool
+
+// NewServer starts a server holding a single company. The caller must call
+// Close when done.
+func NewServer() *Server {
+	s := &Server{
+		company: onsched.Company{
+			Object:            "company",
+			ID:                "test-company",
+			Name:              "Test Company",
+			RegistrationDate:  "2023-01-01",
+			RegistrationEmail: "owner@example.com",
+			AddressLine1:      "1 Main Street",
+			Email:             "info@example.com",
+			TimezoneID:        "1",
+			TimezoneName:      "America/Toronto",
+		},
+		locations: newStore("location", func(l *onsched.Location) *string { return &l.ID }),
+		services:  newStore("service", func(s *onsched.Service) *string { return &s.ID }),
+		resources: newStore("resource", func(r *onsched.Resource) *string { return &r.ID }),
+		holidays:  newStore("holiday", func(h *onsched.Holiday) *string { return &h.ID }),
+		groups:    newStore("servicegroup", func(g *onsched.ServiceGroup) *string { return &g.ID }),
+
+		resourceGroups:   newStore("resourcegroup", func(g *onsched.ResourceGroup) *string { return &g.ID }),
+		allocations:      newStore("allocation", func(a *onsched.ServiceAllocation) *string { return &a.ID }),
+		members:          links{},
+		serviceResources: links{},
+	}
+	s.holidays.parent = func(h *onsched.Holiday) *string { return &h.LocationID }
+	s.allocations.parent = func(a *onsched.ServiceAllocation) *string { return &a.ServiceID }
+	// Assign defaults to new locations, services and resources, so that tests
+	// catch attributes tracked although left out of the configuration.
+	s.locations.defaults = func(l *onsched.Location) {
+		l.FriendlyID = "location-" + l.ID
+		l.TimezoneID = l.TimezoneName
+		if l.Address == (onsched.Address{}) {
+			l.Address.Country = "CA"
+		}
+		if l.Settings == (onsched.LocationSettings{}) {
+			l.Settings.EnableEmailNotifications = true
+		}
+	}
+	s.resources.defaults = func(r *onsched.Resource) {
+		if r.TimezoneName == "" {
+			r.TimezoneName = s.company.TimezoneName
+		}
+	}
+	s.services.defaults = func(svc *onsched.Service) {
+		if svc.Fees == (onsched.ServiceFees{}) {
+			svc.Fees.FeeTaxable = true
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/connect/token", s.token)
+	mux.Handle("/setup/v1/", s.authenticated(http.HandlerFunc(s.setup)))
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// APIURL returns the base URL to configure the client with.
+func (s *Server) APIURL() string {
+	return s.URL
+}
+
+// TokenURL returns the OAuth2 token URL to configure the client with.
+func (s *Server) TokenURL() string {
+	return s.URL + "/connect/token"
+}
+
+// Client returns a client configured to use the server.
+func (s *Server) Client(opts ...onsched.Option) *onsched.Client {
+	opts = append([]onsched.Option{
+		onsched.WithAPIURL(s.APIURL()),
+		onsched.WithTokenURL(s.TokenURL()),
+	}, opts...)
+	return onsched.NewClient(onsched.Sandbox, ClientID, ClientSecret, opts...)
+}
+
+// Company returns the current company.
+func (s *Server) Company() onsched.Company {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.company
+}
+
+// SetCompany replaces the company, e.g. to simulate changes made outside of
+// Terraform.
+func (s *Server) SetCompany(company onsched.Company) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.company = company
+}
+
+// Location returns the location with the given ID.
+func (s *Server) Location(id string) (onsched.Location, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	location, ok := s.locations.items[id]
+	return location, ok
+}
+
+// Service returns the service with the given ID.
+func (s *Server) Service(id string) (onsched.Service, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	service, ok := s.services.items[id]
+	return service, ok
+}
+
+// Resource returns the resource with the given ID.
+func (s *Server) Resource(id string) (onsched.Resource, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resource, ok := s.resources.items[id]
+	return resource, ok
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		id, secret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	}
+	if id != ClientID || secret != ClientSecret {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, onsched.TokenResponse{
+		AccessToken: accessToken,
+		ExpiresIn:   3600,
+		TokenType:   "Bearer",
+	})
+}
+
+func (s *Server) authenticated(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+accessToken {
+			writeError(w, http.StatusUnauthorized, "unauthorized", "missing or invalid access token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) setup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/setup/v1/"), "/")
+	collection, id := parts[0], ""
+	if len(parts) > 1 {
+		id = parts[1]
+	}
+	switch {
+	case collection == "companies":
+		s.companies(w, r)
+	case collection == "locations" && len(parts) > 2 && parts[2] == "holidays":
+		if _, ok := s.locations.items[id]; !ok {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("location %s not found", id))
+			return
+		}
+		s.holidays.serve(w, r, id, strings.Join(parts[3:], "/"))
+	case collection == "locations":
+		s.locations.serve(w, r, "", id)
+	case collection == "services" && len(parts) > 2 && parts[2] == "resources":
+		if _, ok := s.services.items[id]; !ok {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("service %s not found", id))
+			return
+		}
+		s.serveLinks(w, r, s.serviceResources, id, strings.Join(parts[3:], "/"))
+	case collection == "services" && len(parts) > 2 && parts[2] == "allocations":
+		if _, ok := s.services.items[id]; !ok {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("service %s not found", id))
+			return
+		}
+		s.allocations.serve(w, r, id, strings.Join(parts[3:], "/"))
+	case collection == "services":
+		s.services.serve(w, r, "", id)
+		if r.Method == http.MethodDelete {
+			delete(s.serviceResources, id)
+		}
+	case collection == "resources":
+		s.resources.serve(w, r, "", id)
+	case collection == "servicegroups":
+		s.groups.serve(w, r, "", id)
+	case collection == "resourcegroups" && len(parts) > 2 && parts[2] == "resources":
+		if _, ok := s.resourceGroups.items[id]; !ok {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("resourcegroup %s not found", id))
+			return
+		}
+		s.serveLinks(w, r, s.members, id, strings.Join(parts[3:], "/"))
+	case collection == "resourcegroups":
+		s.resourceGroups.serve(w, r, "", id)
+		if r.Method == http.MethodDelete {
+			delete(s.members, id)
+		}
+	default:
+		writeError(w, http.StatusNotFound, "not_found", "unknown endpoint")
+	}
+}
+
+func (s *Server) companies(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.company)
+	case http.MethodPut:
+		company := s.company
+		if !decode(w, r, &company) {
+			return
+		}
+		// Read-only fields can't be changed through the API.
+		company.Object = s.company.Object
+		company.ID = s.company.ID
+		company.RegistrationDate = s.company.RegistrationDate
+		company.RegistrationEmail = s.company.RegistrationEmail
+		s.company = company
+		writeJSON(w, http.StatusOK, s.company)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	}
+}
+
+// ResourceGroupMembers returns the IDs of the resources in the group, in
+// ascending order.
+func (s *Server) ResourceGroupMembers(groupID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedIDs(s.members[groupID])
+}
+
+// ServiceResources returns the IDs of the resources linked to the service,
+// in ascending order.
+func (s *Server) ServiceResources(serviceID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedIDs(s.serviceResources[serviceID])
+}
+
+func sortedIDs(set map[string]bool) []string {
+	ids := []string{}
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// serveLinks serves the resources linked to the object with the given ID,
+// e.g. setup/v1/resourcegroups/<ownerID>/resources.
+func (s *Server) serveLinks(w http.ResponseWriter, r *http.Request, all links, ownerID, resourceID string) {
+	linked := all[ownerID]
+	if linked == nil {
+		linked = map[string]bool{}
+		all[ownerID] = linked
+	}
+
+	switch {
+	case resourceID == "" && r.Method == http.MethodGet:
+		resources := newStore("resource", func(r *onsched.Resource) *string { return &r.ID })
+		for id := range linked {
+			resources.items[id] = s.resources.items[id]
+		}
+		resources.list(w, r, "")
+	case resourceID == "" && r.Method == http.MethodPost:
+		var link struct {
+			ResourceID string `json:"resourceId"`
+		}
+		if !decode(w, r, &link) {
+			return
+		}
+		resource, ok := s.resources.items[link.ResourceID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("resource %s not found", link.ResourceID))
+			return
+		}
+		linked[link.ResourceID] = true
+		writeJSON(w, http.StatusOK, resource)
+	case resourceID != "" && r.Method == http.MethodDelete:
+		if !linked[resourceID] {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("resource %s is not linked", resourceID))
+			return
+		}
+		delete(linked, resourceID)
+		writeJSON(w, http.StatusOK, s.resources.items[resourceID])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	}
+}
+
+// store is an in-memory collection served under setup/v1/<collection>.
+type store[T any] struct {
+	object string
+	id     func(*T) *string
+	// parent returns the field holding the ID of the object the item is
+	// nested under, if any.
+	parent func(*T) *string
+	// defaults fills in the values the API assigns to new items.
+	defaults func(*T)
+	items    map[string]T
+	next     int
+}
+
+func newStore[T any](object string, id func(*T) *string) *store[T] {
+	return &store[T]{object: object, id: id, items: map[string]T{}}
+}
+
+// serve handles a request for the item with the given ID, or for the whole
+// collection when id is empty. parentID scopes nested collections.
+func (s *store[T]) serve(w http.ResponseWriter, r *http.Request, parentID, id string) {
+	switch {
+	case id == "" && r.Method == http.MethodPost:
+		var item T
+		if !decode(w, r, &item) {
+			return
+		}
+		s.next++
+		*s.id(&item) = fmt.Sprintf("%s-%d", s.object, s.next)
+		if s.parent != nil {
+			*s.parent(&item) = parentID
+		}
+		if s.defaults != nil {
+			s.defaults(&item)
+		}
+		s.items[*s.id(&item)] = item
+		writeJSON(w, http.StatusOK, item)
+	case id == "" && r.Method == http.MethodGet:
+		s.list(w, r, parentID)
+	case id == "":
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+	default:
+		item, ok := s.items[id]
+		if ok && s.parent != nil {
+			ok = *s.parent(&item) == parentID
+		}
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s not found", s.object, id))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, item)
+		case http.MethodPut:
+			if !decode(w, r, &item) {
+				return
+			}
+			*s.id(&item) = id
+			if s.parent != nil {
+				*s.parent(&item) = parentID
+			}
+			s.items[id] = item
+			writeJSON(w, http.StatusOK, item)
+		case http.MethodDelete:
+			delete(s.items, id)
+			writeJSON(w, http.StatusOK, item)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method)
+		}
+	}
+}
+
+// list writes a page of the items under parentID, ordered by ID, honoring
+// the offset and limit query parameters.
+func (s *store[T]) list(w http.ResponseWriter, r *http.Request, parentID string) {
+	ids := make([]string, 0, len(s.items))
+	for id, item := range s.items {
+		if s.parent == nil || *s.parent(&item) == parentID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	page := []T{}
+	for i := offset; i < len(ids) && len(page) < limit; i++ {
+		page = append(page, s.items[ids[i]])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"object": "list",
+		"count":  len(page),
+		"total":  len(ids),
+		"data":   page,
+	})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return false
+	}
+	return true
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]string{"code": code, "message": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", requestID.next()))
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+var requestID counter
+
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c *counter) next() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.n++
+	return c.n
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type businessHoursResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &businessHoursResource{}
+	_ resource.ResourceWithConfigure   = &businessHoursResource{}
+	_ resource.ResourceWithImportState = &businessHoursResource{}
+)
+
+// NewBusinessHoursResource is a helper function to simplify the provider implementation.
+func NewBusinessHoursResource() resource.Resource {
+	return &businessHoursResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *businessHoursResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *businessHoursResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_business_hours"
+}
+
+// Schema defines the schema for the resource.
+func (r *businessHoursResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := weeklyHoursAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the location the hours belong to.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["location_id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the location.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The opening hours of an OnSched location per weekday. " +
+			"Leave `business_hours` of the `onsched_location` unset when using this resource, as both manage the same hours. " +
+			"Destroying the resource closes the location on every day.",
+
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *businessHoursResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan businessHoursResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	hours, err := plan.weeklyHours().toBusinessHours()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched business hours", err.Error())
+		return
+	}
+
+	hours, err = r.client.UpdateBusinessHours(ctx, plan.LocationID.ValueString(), hours)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched business hours", err)
+		return
+	}
+
+	plan.ID = plan.LocationID
+	plan.refresh(hours)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *businessHoursResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state businessHoursResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	location, err := r.client.GetLocation(ctx, state.LocationID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && location.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched business hours", err)
+		return
+	}
+
+	state.ID = types.StringValue(location.ID)
+	state.LocationID = types.StringValue(location.ID)
+	state.refresh(location.BusinessHours)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *businessHoursResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan businessHoursResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	hours, err := plan.weeklyHours().toBusinessHours()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched business hours", err.Error())
+		return
+	}
+
+	hours, err = r.client.UpdateBusinessHours(ctx, plan.LocationID.ValueString(), hours)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched business hours", err)
+		return
+	}
+
+	plan.refresh(hours)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete closes the location on every day.
+func (r *businessHoursResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state businessHoursResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.UpdateBusinessHours(ctx, state.LocationID.ValueString(), onsched.BusinessHours{})
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched business hours", err)
+		return
+	}
+}
+
+// ImportState imports the hours of the location with the given ID.
+func (r *businessHoursResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("location_id"), req, resp)
+}
+
+type businessHoursResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	LocationID types.String   `tfsdk:"location_id"`
+	Mon        *dayHoursModel `tfsdk:"mon"`
+	Tue        *dayHoursModel `tfsdk:"tue"`
+	Wed        *dayHoursModel `tfsdk:"wed"`
+	Thu        *dayHoursModel `tfsdk:"thu"`
+	Fri        *dayHoursModel `tfsdk:"fri"`
+	Sat        *dayHoursModel `tfsdk:"sat"`
+	Sun        *dayHoursModel `tfsdk:"sun"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// weeklyHours returns the configured days as a weekly schedule.
+func (m *businessHoursResourceModel) weeklyHours() *weeklyHoursModel {
+	return &weeklyHoursModel{
+		Mon: m.Mon,
+		Tue: m.Tue,
+		Wed: m.Wed,
+		Thu: m.Thu,
+		Fri: m.Fri,
+		Sat: m.Sat,
+		Sun: m.Sun,
+	}
+}
+
+// refresh updates the days from the API representation.
+func (m *businessHoursResourceModel) refresh(hours onsched.BusinessHours) {
+	weekly := newWeeklyHoursModel(hours)
+	if weekly == nil {
+		weekly = &weeklyHoursModel{}
+	}
+	m.Mon, m.Tue, m.Wed, m.Thu, m.Fri, m.Sat, m.Sun = weekly.Mon, weekly.Tue, weekly.Wed, weekly.Thu, weekly.Fri, weekly.Sat, weekly.Sun
+}
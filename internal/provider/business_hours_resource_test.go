@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-onsched/internal/onschedtest"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBusinessHoursResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	const location = `
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Toronto"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + location + `
+resource "onsched_business_hours" "test" {
+  location_id = onsched_location.test.id
+
+  mon = { start_time = "09:00", end_time = "17:00" }
+  sat = { start_time = "10:00", end_time = "14:30" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("onsched_business_hours.test", "id", "onsched_location.test", "id"),
+					resource.TestCheckResourceAttr("onsched_business_hours.test", "mon.start_time", "09:00"),
+					resource.TestCheckResourceAttr("onsched_business_hours.test", "sat.end_time", "14:30"),
+					resource.TestCheckNoResourceAttr("onsched_business_hours.test", "tue.start_time"),
+					testAccCheckBusinessHours(server, "onsched_location.test", onsched.BusinessHours{
+						Mon: onsched.DayHours{StartTime: 900, EndTime: 1700},
+						Sat: onsched.DayHours{StartTime: 1000, EndTime: 1430},
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_business_hours.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, the location keeps the hours
+			{
+				Config: providerConfig + `
+resource "onsched_location" "test" {
+  name          = "Downtown Toronto"
+  timezone_name = "America/Toronto"
+}
+
+resource "onsched_business_hours" "test" {
+  location_id = onsched_location.test.id
+
+  mon = { start_time = "08:30", end_time = "17:00" }
+  sun = { start_time = "12:00", end_time = "16:00" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_location.test", "name", "Downtown Toronto"),
+					resource.TestCheckNoResourceAttr("onsched_business_hours.test", "sat.start_time"),
+					testAccCheckBusinessHours(server, "onsched_location.test", onsched.BusinessHours{
+						Mon: onsched.DayHours{StartTime: 830, EndTime: 1700},
+						Sun: onsched.DayHours{StartTime: 1200, EndTime: 1600},
+					}),
+				),
+			},
+			// Delete closes the location
+			{
+				Config: providerConfig + location,
+				Check:  testAccCheckBusinessHours(server, "onsched_location.test", onsched.BusinessHours{}),
+			},
+		},
+	})
+}
+
+func TestAccBusinessHoursResource_invalidRange(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "onsched_business_hours" "test" {
+  location_id = "location-1"
+
+  fri = { start_time = "17:00", end_time = "09:00" }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Time Range`),
+			},
+		},
+	})
+}
+
+// testAccCheckBusinessHours verifies the hours stored by the server for the
+// location resource with the given name.
+func testAccCheckBusinessHours(server *onschedtest.Server, name string, want onsched.BusinessHours) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+		location, ok := server.Location(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("location %s not found", rs.Primary.ID)
+		}
+		if location.BusinessHours != want {
+			return fmt.Errorf("business hours = %+v, want %+v", location.BusinessHours, want)
+		}
+		return nil
+	}
+}
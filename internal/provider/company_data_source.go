@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type companyDataSource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &companyDataSource{}
+	_ datasource.DataSourceWithConfigure = &companyDataSource{}
+)
+
+// NewCompanyDataSource is a helper function to simplify the provider implementation.
+func NewCompanyDataSource() datasource.DataSource {
+	return &companyDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *companyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *companyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_company"
+}
+
+// Schema defines the schema for the data source.
+func (d *companyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the OnSched company the provider credentials belong to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Company ID.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the company.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Contact email address of the company.",
+				Computed:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "Phone number of the company.",
+				Computed:            true,
+			},
+			"website": schema.StringAttribute{
+				MarkdownDescription: "Website of the company.",
+				Computed:            true,
+			},
+			"registration_date": schema.StringAttribute{
+				MarkdownDescription: "Date the company was registered with OnSched.",
+				Computed:            true,
+			},
+			"registration_email": schema.StringAttribute{
+				MarkdownDescription: "Email address the company was registered with.",
+				Computed:            true,
+			},
+			"timezone_id": schema.StringAttribute{
+				MarkdownDescription: "OnSched timezone ID of the company.",
+				Computed:            true,
+			},
+			"timezone_name": schema.StringAttribute{
+				MarkdownDescription: "Timezone name of the company.",
+				Computed:            true,
+			},
+			"notification_from_email_address": schema.StringAttribute{
+				MarkdownDescription: "Email address notifications are sent from.",
+				Computed:            true,
+			},
+			"notification_from_name": schema.StringAttribute{
+				MarkdownDescription: "Sender name used for notifications.",
+				Computed:            true,
+			},
+			"disable_email_and_sms_notifications": schema.BoolAttribute{
+				MarkdownDescription: "Whether email and sms notifications are disabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *companyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	c, err := d.client.GetCompany(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched company", err)
+		return
+	}
+
+	state := companyDataSourceModel{
+		ID:                              types.StringValue(c.ID),
+		Name:                            types.StringValue(c.Name),
+		Email:                           types.StringValue(c.Email),
+		Phone:                           types.StringValue(c.Phone),
+		Website:                         types.StringValue(c.Website),
+		RegistrationDate:                types.StringValue(c.RegistrationDate),
+		RegistrationEmail:               types.StringValue(c.RegistrationEmail),
+		TimezoneID:                      types.StringValue(c.TimezoneID),
+		TimezoneName:                    types.StringValue(c.TimezoneName),
+		NotificationFromEmailAddress:    types.StringValue(c.NotificationFromEmailAddress),
+		NotificationFromName:            types.StringValue(c.NotificationFromName),
+		DisableEmailAndSmsNotifications: types.BoolValue(c.DisableEmailAndSmsNotifications),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type companyDataSourceModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	Email                           types.String `tfsdk:"email"`
+	Phone                           types.String `tfsdk:"phone"`
+	Website                         types.String `tfsdk:"website"`
+	RegistrationDate                types.String `tfsdk:"registration_date"`
+	RegistrationEmail               types.String `tfsdk:"registration_email"`
+	TimezoneID                      types.String `tfsdk:"timezone_id"`
+	TimezoneName                    types.String `tfsdk:"timezone_name"`
+	NotificationFromEmailAddress    types.String `tfsdk:"notification_from_email_address"`
+	NotificationFromName            types.String `tfsdk:"notification_from_name"`
+	DisableEmailAndSmsNotifications types.Bool   `tfsdk:"disable_email_and_sms_notifications"`
+}
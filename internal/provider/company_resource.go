@@ -6,9 +6,13 @@ import (
 	"terraform-provider-onsched/onsched"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,11 +22,12 @@ type companyResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &companyResource{}
-	_ resource.ResourceWithConfigure = &companyResource{}
+	_ resource.Resource                = &companyResource{}
+	_ resource.ResourceWithConfigure   = &companyResource{}
+	_ resource.ResourceWithImportState = &companyResource{}
 )
 
-// NewcompanyResource is a helper function to simplify the provider implementation.
+// NewCompanyResource is a helper function to simplify the provider implementation.
 func NewCompanyResource() resource.Resource {
 	return &companyResource{}
 }
@@ -37,7 +42,7 @@ func (r *companyResource) Configure(_ context.Context, req resource.ConfigureReq
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
+			"Unexpected Resource Configure Type",
 			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
@@ -53,89 +58,253 @@ func (r *companyResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 // Schema defines the schema for the resource.
-func (r *companyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *companyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "The OnSched company the provider credentials belong to. " +
+			"A company cannot be created or deleted through the API: creating this resource adopts the existing company " +
+			"and destroying it only removes it from the Terraform state. Optional attributes left out of the configuration " +
+			"keep their current value.",
+
 		Attributes: map[string]schema.Attribute{
-			"object": schema.StringAttribute{
-				Computed: true,
-			},
 			"id": schema.StringAttribute{
-				Computed: true,
+				MarkdownDescription: "Company ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				MarkdownDescription: "Name of the company.",
+				Required:            true,
 			},
-			"registrationDate": schema.StringAttribute{
-				Computed: true,
+			"registration_date": schema.StringAttribute{
+				MarkdownDescription: "Date the company was registered with OnSched.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"registrationEmail": schema.StringAttribute{
-				Computed: true,
+			"registration_email": schema.StringAttribute{
+				MarkdownDescription: "Email address the company was registered with.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"addressLine1": schema.StringAttribute{
-				Required: true,
+			"deleted_status": schema.BoolAttribute{
+				MarkdownDescription: "Whether the company has been deleted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"addressLine2": schema.StringAttribute{
-				Optional: true,
+			"deleted_time": schema.StringAttribute{
+				MarkdownDescription: "Time the company was deleted, if it has been deleted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address_line1": schema.StringAttribute{
+				MarkdownDescription: "First line of the company address.",
+				Required:            true,
+			},
+			"address_line2": schema.StringAttribute{
+				MarkdownDescription: "Second line of the company address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"city": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "City of the company address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"state": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "State or province of the company address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"postalCode": schema.StringAttribute{
-				Optional: true,
+			"postal_code": schema.StringAttribute{
+				MarkdownDescription: "Postal code of the company address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"country": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "Country of the company address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"phone": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "Phone number of the company.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"fax": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "Fax number of the company.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"email": schema.StringAttribute{
-				Required: true,
+				MarkdownDescription: "Contact email address of the company.",
+				Required:            true,
 			},
 			"website": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "Website of the company.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timezone_id": schema.StringAttribute{
+				MarkdownDescription: "OnSched timezone ID of the company.",
+				Required:            true,
+			},
+			"timezone_name": schema.StringAttribute{
+				MarkdownDescription: "Timezone name of the company.",
+				Required:            true,
+			},
+			"notification_from_email_address": schema.StringAttribute{
+				MarkdownDescription: "Email address notifications are sent from.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"timezoneId": schema.StringAttribute{
-				Required: true,
+			"notification_from_name": schema.StringAttribute{
+				MarkdownDescription: "Sender name used for notifications.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"timezoneName": schema.StringAttribute{
-				Required: true,
+			"booking_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "Webhook called when a booking event occurs.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"bookingWebhookUrl": schema.StringAttribute{
-				Optional: true,
+			"customer_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "Webhook called when a customer event occurs.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"customerWebhookUrl": schema.StringAttribute{
-				Optional: true,
+			"reminder_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "Webhook called when a reminder event occurs.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"reminderWebhookUrl": schema.StringAttribute{
-				Optional: true,
+			"resource_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "Webhook called when a resource event occurs.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"resourceWebhookUrl": schema.StringAttribute{
-				Optional: true,
+			"webhook_signature_hash": schema.StringAttribute{
+				MarkdownDescription: "Webhook signature hash. Left unset, the current secret is kept and not stored in state.",
+				Optional:            true,
+				Sensitive:           true,
+				DeprecationMessage: "Configured secrets are stored in state. Use the onsched_webhook_signature resource instead; " +
+					"this argument will be removed in the next major version.",
 			},
-			"webhookSignatureHash": schema.StringAttribute{
-				Optional: true,
+			"webhook_signature_hash_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Salted fingerprint of the current webhook signature hash, which changes when the secret is rotated. " +
+					"The secret itself is managed with `onsched_webhook_signature`. " +
+					"It is unknown until applied, because the secret may be rotated in the same apply.",
+				Computed: true,
+			},
+			"disable_email_and_sms_notifications": schema.BoolAttribute{
+				MarkdownDescription: "This will disable all email and sms notifications, webhooks will still be triggered",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"disableEmailAndSmsNotifications": schema.BoolAttribute{
-				Optional: true,
-				Default:  booldefault.StaticBool(false),
+			"last_updated": schema.StringAttribute{
+				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-// Create creates the resource and sets the initial Terraform state.
+// Create adopts the existing company and applies the planned values to it.
 func (r *companyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	resp.Diagnostics.AddError(
-		"Creating a company is not supported",
-		"A company is expected to be created by an OnSched representative, use this resource to update the company",
-	)
+	// Retrieve values from plan
+	var plan companyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	company, err := r.client.MutateCompany(ctx, nil, func(company *onsched.Company) error {
+		plan.apply(company)
+		return nil
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched company", err)
+		return
+	}
+
+	plan.refresh(company)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -147,42 +316,26 @@ func (r *companyResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	c, err := r.client.GetCompany()
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	c, err := r.client.GetCompany(ctx)
+	if onsched.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading OnSched company",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error reading OnSched company", err)
 		return
 	}
 
-	state.Object = types.StringValue(c.Object)
-	state.ID = types.StringValue(c.ID)
-	state.Name = types.StringValue(c.Name)
-	state.RegistrationDate = types.StringValue(c.RegistrationDate)
-	state.RegistrationEmail = types.StringValue(c.RegistrationEmail)
-	state.DeletedStatus = types.BoolValue(c.DeletedStatus)
-	state.DeletedTime = types.StringValue(c.DeletedTime)
-	state.AddressLine1 = types.StringValue(c.AddressLine1)
-	state.AddressLine2 = types.StringValue(c.AddressLine2)
-	state.City = types.StringValue(c.City)
-	state.State = types.StringValue(c.State)
-	state.PostalCode = types.StringValue(c.PostalCode)
-	state.Country = types.StringValue(c.Country)
-	state.Phone = types.StringValue(c.Phone)
-	state.Fax = types.StringValue(c.Fax)
-	state.Email = types.StringValue(c.Email)
-	state.Website = types.StringValue(c.Website)
-	state.TimezoneID = types.StringValue(c.TimezoneID)
-	state.TimezoneName = types.StringValue(c.TimezoneName)
-	state.NotificationFromEmailAddress = types.StringValue(c.NotificationFromEmailAddress)
-	state.NotificationFromName = types.StringValue(c.NotificationFromName)
-	state.BookingWebhookURL = types.StringValue(c.BookingWebhookURL)
-	state.CustomerWebhookURL = types.StringValue(c.CustomerWebhookURL)
-	state.ReminderWebhookURL = types.StringValue(c.ReminderWebhookURL)
-	state.ResourceWebhookURL = types.StringValue(c.ResourceWebhookURL)
-	state.WebhookSignatureHash = types.StringValue(c.WebhookSignatureHash)
-	state.DisableEmailAndSmsNotifications = types.BoolValue(c.DisableEmailAndSmsNotifications)
+	state.refresh(c)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -190,7 +343,6 @@ func (r *companyResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -203,74 +355,34 @@ func (r *companyResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	company := onsched.Company{
-		Object:                          plan.Object.ValueString(),
-		ID:                              plan.ID.ValueString(),
-		Name:                            plan.Name.ValueString(),
-		RegistrationDate:                plan.RegistrationDate.ValueString(),
-		RegistrationEmail:               plan.RegistrationEmail.ValueString(),
-		DeletedStatus:                   plan.DeletedStatus.ValueBool(),
-		DeletedTime:                     plan.DeletedTime.ValueString(),
-		AddressLine1:                    plan.AddressLine1.ValueString(),
-		AddressLine2:                    plan.AddressLine2.ValueString(),
-		City:                            plan.City.ValueString(),
-		State:                           plan.State.ValueString(),
-		PostalCode:                      plan.PostalCode.ValueString(),
-		Country:                         plan.Country.ValueString(),
-		Phone:                           plan.Phone.ValueString(),
-		Fax:                             plan.Fax.ValueString(),
-		Email:                           plan.Email.ValueString(),
-		Website:                         plan.Website.ValueString(),
-		TimezoneID:                      plan.TimezoneID.ValueString(),
-		TimezoneName:                    plan.TimezoneName.ValueString(),
-		NotificationFromEmailAddress:    plan.NotificationFromEmailAddress.ValueString(),
-		NotificationFromName:            plan.NotificationFromName.ValueString(),
-		BookingWebhookURL:               plan.BookingWebhookURL.ValueString(),
-		CustomerWebhookURL:              plan.CustomerWebhookURL.ValueString(),
-		ReminderWebhookURL:              plan.ReminderWebhookURL.ValueString(),
-		ResourceWebhookURL:              plan.ResourceWebhookURL.ValueString(),
-		WebhookSignatureHash:            plan.WebhookSignatureHash.ValueString(),
-		DisableEmailAndSmsNotifications: plan.DisableEmailAndSmsNotifications.ValueBool(),
+	var state companyResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	_, err := r.client.UpdateCompany(company)
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	company, err := r.client.MutateCompany(ctx, companyConflicts(state.apply), func(company *onsched.Company) error {
+		plan.apply(company)
+		return nil
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched company",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error updating OnSched company", err)
 		return
 	}
 
-	company, err = r.client.GetCompany()
-
-	plan.Object = types.StringValue(company.Object)
-	plan.ID = types.StringValue(company.ID)
-	plan.Name = types.StringValue(company.Name)
-	plan.RegistrationDate = types.StringValue(company.RegistrationDate)
-	plan.RegistrationEmail = types.StringValue(company.RegistrationEmail)
-	plan.DeletedStatus = types.BoolValue(company.DeletedStatus)
-	plan.DeletedTime = types.StringValue(company.DeletedTime)
-	plan.AddressLine1 = types.StringValue(company.AddressLine1)
-	plan.AddressLine2 = types.StringValue(company.AddressLine2)
-	plan.City = types.StringValue(company.City)
-	plan.State = types.StringValue(company.State)
-	plan.PostalCode = types.StringValue(company.PostalCode)
-	plan.Country = types.StringValue(company.Country)
-	plan.Phone = types.StringValue(company.Phone)
-	plan.Fax = types.StringValue(company.Fax)
-	plan.Email = types.StringValue(company.Email)
-	plan.Website = types.StringValue(company.Website)
-	plan.TimezoneID = types.StringValue(company.TimezoneID)
-	plan.TimezoneName = types.StringValue(company.TimezoneName)
-	plan.NotificationFromEmailAddress = types.StringValue(company.NotificationFromEmailAddress)
-	plan.NotificationFromName = types.StringValue(company.NotificationFromName)
-	plan.BookingWebhookURL = types.StringValue(company.BookingWebhookURL)
-	plan.CustomerWebhookURL = types.StringValue(company.CustomerWebhookURL)
-	plan.ReminderWebhookURL = types.StringValue(company.ReminderWebhookURL)
-	plan.ResourceWebhookURL = types.StringValue(company.ResourceWebhookURL)
-	plan.WebhookSignatureHash = types.StringValue(company.WebhookSignatureHash)
-	plan.DisableEmailAndSmsNotifications = types.BoolValue(company.DisableEmailAndSmsNotifications)
+	// Keep the salt of the fingerprint, which is unknown in the plan.
+	plan.WebhookSignatureFingerprint = state.WebhookSignatureFingerprint
+	plan.refresh(company)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -280,37 +392,143 @@ func (r *companyResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 }
 
-// Delete deletes the resource and removes the Terraform state on success.
+// Delete removes the company from the Terraform state. The company itself is
+// left untouched as it cannot be deleted through the API.
 func (r *companyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"OnSched company was not deleted",
+		"A company can only be deleted by an OnSched representative. It has been removed from the Terraform state only.",
+	)
+}
+
+// ImportState imports the company of the configured credentials by its ID.
+func (r *companyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompanyState(ctx, r.client, req, resp)
+}
+
+// companyConflicts returns a MutateCompany check reporting the fields whose
+// current value differs from the one apply sets from the prior state, i.e.
+// the fields of the resource that were changed since the last refresh.
+func companyConflicts(apply func(*onsched.Company)) func(onsched.Company) []string {
+	return func(current onsched.Company) []string {
+		prior := current
+		apply(&prior)
+		return onsched.ChangedFields(prior, current)
+	}
+}
+
+// importCompanyState imports a resource backed by the company object, keyed
+// by the company ID. As the company is implied by the provider credentials,
+// the ID only guards against importing into the wrong provider configuration.
+func importCompanyState(ctx context.Context, client *onsched.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	company, err := client.GetCompany(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error importing OnSched company", err)
+		return
+	}
+
+	if company.ID != req.ID {
+		resp.Diagnostics.AddError(
+			"Error importing OnSched company",
+			fmt.Sprintf("The provider credentials belong to company %q, not %q.", company.ID, req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 type companyResourceModel struct {
-	Object                          types.String `tfsdk:"object"`
-	ID                              types.String `tfsdk:"id"`
-	Name                            types.String `tfsdk:"name"`
-	RegistrationDate                types.String `tfsdk:"registrationDate"`
-	RegistrationEmail               types.String `tfsdk:"registrationEmail"`
-	DeletedStatus                   types.Bool   `tfsdk:"deletedStatus"`
-	DeletedTime                     types.String `tfsdk:"deletedTime"`
-	AddressLine1                    types.String `tfsdk:"addressLine1"`
-	AddressLine2                    types.String `tfsdk:"addressLine2"`
-	City                            types.String `tfsdk:"city"`
-	State                           types.String `tfsdk:"state"`
-	PostalCode                      types.String `tfsdk:"postalCode"`
-	Country                         types.String `tfsdk:"country"`
-	Phone                           types.String `tfsdk:"phone"`
-	Fax                             types.String `tfsdk:"fax"`
-	Email                           types.String `tfsdk:"email"`
-	Website                         types.String `tfsdk:"website"`
-	TimezoneID                      types.String `tfsdk:"timezoneId"`
-	TimezoneName                    types.String `tfsdk:"timezoneName"`
-	NotificationFromEmailAddress    types.String `tfsdk:"notificationFromEmailAddress"`
-	NotificationFromName            types.String `tfsdk:"notificationFromName"`
-	BookingWebhookURL               types.String `tfsdk:"bookingWebhookUrl"`
-	CustomerWebhookURL              types.String `tfsdk:"customerWebhookUrl"`
-	ReminderWebhookURL              types.String `tfsdk:"reminderWebhookUrl"`
-	ResourceWebhookURL              types.String `tfsdk:"resourceWebhookUrl"`
-	WebhookSignatureHash            types.String `tfsdk:"webhookSignatureHash"`
-	DisableEmailAndSmsNotifications types.Bool   `tfsdk:"disableEmailAndSmsNotifications"`
-	LastUpdated                     types.String `tfsdk:"last_updated"`
+	ID                              types.String   `tfsdk:"id"`
+	Name                            types.String   `tfsdk:"name"`
+	RegistrationDate                types.String   `tfsdk:"registration_date"`
+	RegistrationEmail               types.String   `tfsdk:"registration_email"`
+	DeletedStatus                   types.Bool     `tfsdk:"deleted_status"`
+	DeletedTime                     types.String   `tfsdk:"deleted_time"`
+	AddressLine1                    types.String   `tfsdk:"address_line1"`
+	AddressLine2                    types.String   `tfsdk:"address_line2"`
+	City                            types.String   `tfsdk:"city"`
+	State                           types.String   `tfsdk:"state"`
+	PostalCode                      types.String   `tfsdk:"postal_code"`
+	Country                         types.String   `tfsdk:"country"`
+	Phone                           types.String   `tfsdk:"phone"`
+	Fax                             types.String   `tfsdk:"fax"`
+	Email                           types.String   `tfsdk:"email"`
+	Website                         types.String   `tfsdk:"website"`
+	TimezoneID                      types.String   `tfsdk:"timezone_id"`
+	TimezoneName                    types.String   `tfsdk:"timezone_name"`
+	NotificationFromEmailAddress    types.String   `tfsdk:"notification_from_email_address"`
+	NotificationFromName            types.String   `tfsdk:"notification_from_name"`
+	BookingWebhookURL               types.String   `tfsdk:"booking_webhook_url"`
+	CustomerWebhookURL              types.String   `tfsdk:"customer_webhook_url"`
+	ReminderWebhookURL              types.String   `tfsdk:"reminder_webhook_url"`
+	ResourceWebhookURL              types.String   `tfsdk:"resource_webhook_url"`
+	WebhookSignatureHash            types.String   `tfsdk:"webhook_signature_hash"`
+	WebhookSignatureFingerprint     types.String   `tfsdk:"webhook_signature_hash_fingerprint"`
+	DisableEmailAndSmsNotifications types.Bool     `tfsdk:"disable_email_and_sms_notifications"`
+	LastUpdated                     types.String   `tfsdk:"last_updated"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// apply copies the configured values onto company, leaving fields that are
+// null or unknown in the plan at their current value.
+func (m *companyResourceModel) apply(company *onsched.Company) {
+	setString(&company.Name, m.Name)
+	setString(&company.AddressLine1, m.AddressLine1)
+	setString(&company.AddressLine2, m.AddressLine2)
+	setString(&company.City, m.City)
+	setString(&company.State, m.State)
+	setString(&company.PostalCode, m.PostalCode)
+	setString(&company.Country, m.Country)
+	setString(&company.Phone, m.Phone)
+	setString(&company.Fax, m.Fax)
+	setString(&company.Email, m.Email)
+	setString(&company.Website, m.Website)
+	setString(&company.TimezoneID, m.TimezoneID)
+	setString(&company.TimezoneName, m.TimezoneName)
+	setString(&company.NotificationFromEmailAddress, m.NotificationFromEmailAddress)
+	setString(&company.NotificationFromName, m.NotificationFromName)
+	setString(&company.BookingWebhookURL, m.BookingWebhookURL)
+	setString(&company.CustomerWebhookURL, m.CustomerWebhookURL)
+	setString(&company.ReminderWebhookURL, m.ReminderWebhookURL)
+	setString(&company.ResourceWebhookURL, m.ResourceWebhookURL)
+	setString(&company.WebhookSignatureHash, m.WebhookSignatureHash)
+
+	if !m.DisableEmailAndSmsNotifications.IsUnknown() {
+		company.DisableEmailAndSmsNotifications = m.DisableEmailAndSmsNotifications.ValueBool()
+	}
+}
+
+// refresh updates the model from the API representation.
+func (m *companyResourceModel) refresh(c onsched.Company) {
+	m.ID = types.StringValue(c.ID)
+	m.Name = types.StringValue(c.Name)
+	m.RegistrationDate = types.StringValue(c.RegistrationDate)
+	m.RegistrationEmail = types.StringValue(c.RegistrationEmail)
+	m.DeletedStatus = types.BoolValue(c.DeletedStatus)
+	m.DeletedTime = types.StringValue(c.DeletedTime)
+	m.AddressLine1 = types.StringValue(c.AddressLine1)
+	m.AddressLine2 = types.StringValue(c.AddressLine2)
+	m.City = types.StringValue(c.City)
+	m.State = types.StringValue(c.State)
+	m.PostalCode = types.StringValue(c.PostalCode)
+	m.Country = types.StringValue(c.Country)
+	m.Phone = types.StringValue(c.Phone)
+	m.Fax = types.StringValue(c.Fax)
+	m.Email = types.StringValue(c.Email)
+	m.Website = types.StringValue(c.Website)
+	m.TimezoneID = types.StringValue(c.TimezoneID)
+	m.TimezoneName = types.StringValue(c.TimezoneName)
+	m.NotificationFromEmailAddress = types.StringValue(c.NotificationFromEmailAddress)
+	m.NotificationFromName = types.StringValue(c.NotificationFromName)
+	m.BookingWebhookURL = types.StringValue(c.BookingWebhookURL)
+	m.CustomerWebhookURL = types.StringValue(c.CustomerWebhookURL)
+	m.ReminderWebhookURL = types.StringValue(c.ReminderWebhookURL)
+	m.ResourceWebhookURL = types.StringValue(c.ResourceWebhookURL)
+	// The secret is only kept in state when it is managed by this resource.
+	if !m.WebhookSignatureHash.IsNull() {
+		m.WebhookSignatureHash = optionalString(c.WebhookSignatureHash)
+	}
+	m.WebhookSignatureFingerprint = secretFingerprint(c.WebhookSignatureHash, m.WebhookSignatureFingerprint)
+	m.DisableEmailAndSmsNotifications = types.BoolValue(c.DisableEmailAndSmsNotifications)
 }
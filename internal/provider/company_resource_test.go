@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCompanyResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			// Destroying only removes the company from state.
+			if name := server.Company().Name; name != "Renamed Company" {
+				return fmt.Errorf("company was modified on destroy, name is %q", name)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create (adopt) and Read testing
+			{
+				Config: providerConfig + `
+resource "onsched_company" "test" {
+  name          = "Test Company"
+  address_line1 = "1 Main Street"
+  email         = "info@example.com"
+  timezone_id   = "1"
+  timezone_name = "America/Toronto"
+  city          = "Toronto"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_company.test", "id", "test-company"),
+					resource.TestCheckResourceAttr("onsched_company.test", "city", "Toronto"),
+					resource.TestCheckResourceAttr("onsched_company.test", "registration_email", "owner@example.com"),
+					resource.TestCheckResourceAttr("onsched_company.test", "deleted_status", "false"),
+					resource.TestCheckResourceAttr("onsched_company.test", "disable_email_and_sms_notifications", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onsched_company.test",
+				ImportState:             true,
+				ImportStateId:           "test-company",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "onsched_company" "test" {
+  name                   = "Renamed Company"
+  address_line1          = "1 Main Street"
+  email                  = "info@example.com"
+  timezone_id            = "1"
+  timezone_name          = "America/Toronto"
+  city                   = "Toronto"
+  notification_from_name = "Bookings"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_company.test", "name", "Renamed Company"),
+					resource.TestCheckResourceAttr("onsched_company.test", "notification_from_name", "Bookings"),
+					func(*terraform.State) error {
+						if city := server.Company().City; city != "Toronto" {
+							return fmt.Errorf("expected city to be kept, got %q", city)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
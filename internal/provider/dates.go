@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// dateLayout is the calendar date format used by the API.
+const dateLayout = "2006-01-02"
+
+// dateValidator checks that a string is a valid calendar date in
+// YYYY-MM-DD format.
+type dateValidator struct{}
+
+func (v dateValidator) Description(_ context.Context) string {
+	return "must be a date in YYYY-MM-DD format"
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be a date in `YYYY-MM-DD` format"
+}
+
+func (v dateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date",
+			fmt.Sprintf("%q is not a valid date in YYYY-MM-DD format.", value),
+		)
+	}
+}
+
+// formatDate returns the date part of a date or timestamp returned by the API.
+func formatDate(s string) string {
+	if len(s) > len(dateLayout) && s[len(dateLayout)] == 'T' {
+		return s[:len(dateLayout)]
+	}
+	return s
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// addClientError appends an error diagnostic for err, adding guidance for
+// the OnSched API failures users can act on.
+func addClientError(diags *diag.Diagnostics, summary string, err error) {
+	var detail string
+
+	switch {
+	case onsched.IsUnauthorized(err):
+		detail = "OnSched rejected the configured credentials. Check the client_id and client_secret " +
+			"provider values (or ONSCHED_CLIENT_ID and ONSCHED_CLIENT_SECRET) and the selected env."
+	case onsched.IsForbidden(err):
+		detail = "The configured OnSched client is not allowed to perform this operation."
+	case onsched.IsNotFound(err):
+		detail = "The requested OnSched object does not exist."
+	case onsched.IsConflict(err):
+		detail = "The OnSched company was modified outside of Terraform since it was last refreshed. " +
+			"Nothing was saved; refresh the state, review the plan and apply again."
+	case onsched.IsRateLimited(err):
+		detail = "The OnSched API rate limit was exceeded. Wait before retrying the operation."
+	}
+
+	var apiErr *onsched.APIError
+	if errors.As(err, &apiErr) {
+		if detail != "" {
+			detail += "\n\n"
+		}
+		detail += fmt.Sprintf("%s %s returned HTTP %d", apiErr.Method, apiErr.Path, apiErr.StatusCode)
+		if apiErr.Code != "" {
+			detail += fmt.Sprintf(" (%s)", apiErr.Code)
+		}
+		if apiErr.Message != "" {
+			detail += ": " + apiErr.Message
+		}
+		if apiErr.RequestID != "" {
+			detail += "\nRequest ID: " + apiErr.RequestID
+		}
+		diags.AddError(summary, detail)
+		return
+	}
+
+	if detail != "" {
+		detail += "\n\n"
+	}
+	diags.AddError(summary, detail+err.Error())
+}
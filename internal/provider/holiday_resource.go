@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type holidayResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &holidayResource{}
+	_ resource.ResourceWithConfigure   = &holidayResource{}
+	_ resource.ResourceWithImportState = &holidayResource{}
+)
+
+// NewHolidayResource is a helper function to simplify the provider implementation.
+func NewHolidayResource() resource.Resource {
+	return &holidayResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *holidayResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *holidayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holiday"
+}
+
+// Schema defines the schema for the resource.
+func (r *holidayResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A holiday or other closure of an OnSched location.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Holiday ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the location that is closed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the holiday, e.g. `Canada Day`.",
+				Required:            true,
+			},
+			"date": schema.StringAttribute{
+				MarkdownDescription: "Date of the closure in `YYYY-MM-DD` format.",
+				Required:            true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"closed_hours": schema.SingleNestedAttribute{
+				MarkdownDescription: "Part of the day the location is closed. Leave unset to close for the whole day.",
+				Optional:            true,
+				Validators: []validator.Object{
+					timeRangeValidator{},
+				},
+				Attributes: dayHoursAttributes("Start of the closure", "End of the closure"),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *holidayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan holidayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	holiday, err := plan.toHoliday()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched holiday", err.Error())
+		return
+	}
+
+	holiday, err = r.client.CreateHoliday(ctx, holiday)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched holiday", err)
+		return
+	}
+
+	plan.refresh(holiday)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *holidayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state holidayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	holiday, err := r.client.GetHoliday(ctx, state.LocationID.ValueString(), state.ID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && holiday.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched holiday", err)
+		return
+	}
+
+	state.refresh(holiday)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *holidayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan holidayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	holiday, err := plan.toHoliday()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched holiday", err.Error())
+		return
+	}
+
+	holiday, err = r.client.UpdateHoliday(ctx, holiday)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched holiday", err)
+		return
+	}
+
+	plan.refresh(holiday)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *holidayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state holidayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteHoliday(ctx, state.LocationID.ValueString(), state.ID.ValueString())
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched holiday", err)
+		return
+	}
+}
+
+// ImportState imports an existing holiday by "<location_id>/<holiday_id>".
+func (r *holidayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	locationID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || locationID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form <location_id>/<holiday_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location_id"), locationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+type holidayResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	LocationID  types.String   `tfsdk:"location_id"`
+	Name        types.String   `tfsdk:"name"`
+	Date        types.String   `tfsdk:"date"`
+	ClosedHours *dayHoursModel `tfsdk:"closed_hours"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toHoliday converts the model to the API representation.
+func (m *holidayResourceModel) toHoliday() (onsched.Holiday, error) {
+	holiday := onsched.Holiday{
+		ID:         m.ID.ValueString(),
+		LocationID: m.LocationID.ValueString(),
+		Name:       m.Name.ValueString(),
+		Date:       m.Date.ValueString(),
+	}
+
+	if m.ClosedHours != nil {
+		hours, err := m.ClosedHours.toDayHours()
+		if err != nil {
+			return holiday, fmt.Errorf("closed_hours.%w", err)
+		}
+		holiday.StartTime = hours.StartTime
+		holiday.EndTime = hours.EndTime
+	}
+
+	return holiday, nil
+}
+
+// refresh updates the model from the API representation.
+func (m *holidayResourceModel) refresh(h onsched.Holiday) {
+	m.ID = types.StringValue(h.ID)
+	m.LocationID = types.StringValue(h.LocationID)
+	m.Name = types.StringValue(h.Name)
+	m.Date = types.StringValue(formatDate(h.Date))
+
+	m.ClosedHours = nil
+	if !h.FullDay() {
+		m.ClosedHours = newDayHoursModel(h.ClosedHours())
+	}
+}
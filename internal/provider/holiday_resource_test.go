@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHolidayResource(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	const location = `
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Toronto"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + location + `
+resource "onsched_holiday" "canada_day" {
+  location_id = onsched_location.test.id
+  name        = "Canada Day"
+  date        = "2024-07-01"
+}
+
+resource "onsched_holiday" "christmas_eve" {
+  location_id = onsched_location.test.id
+  name        = "Christmas Eve"
+  date        = "2024-12-24"
+
+  closed_hours = {
+    start_time = "12:00"
+    end_time   = "23:59"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("onsched_holiday.canada_day", "id"),
+					resource.TestCheckResourceAttr("onsched_holiday.canada_day", "date", "2024-07-01"),
+					resource.TestCheckNoResourceAttr("onsched_holiday.canada_day", "closed_hours.start_time"),
+					resource.TestCheckResourceAttr("onsched_holiday.christmas_eve", "closed_hours.start_time", "12:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_holiday.christmas_eve",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateID("onsched_holiday.christmas_eve", "location_id", "id"),
+				ImportStateVerify: true,
+			},
+			// Update and data source testing
+			{
+				Config: providerConfig + location + `
+resource "onsched_holiday" "canada_day" {
+  location_id = onsched_location.test.id
+  name        = "Canada Day (observed)"
+  date        = "2024-07-02"
+}
+
+resource "onsched_holiday" "christmas_eve" {
+  location_id = onsched_location.test.id
+  name        = "Christmas Eve"
+  date        = "2024-12-24"
+}
+
+data "onsched_holidays" "test" {
+  location_id = onsched_location.test.id
+
+  depends_on = [onsched_holiday.canada_day, onsched_holiday.christmas_eve]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_holiday.canada_day", "name", "Canada Day (observed)"),
+					resource.TestCheckNoResourceAttr("onsched_holiday.christmas_eve", "closed_hours.start_time"),
+					resource.TestCheckResourceAttr("data.onsched_holidays.test", "holidays.#", "2"),
+					resource.TestCheckResourceAttr("data.onsched_holidays.test", "holidays.0.date", "2024-07-02"),
+					resource.TestCheckResourceAttr("data.onsched_holidays.test", "holidays.1.name", "Christmas Eve"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHolidayResource_invalidDate(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "onsched_holiday" "test" {
+  location_id = "location-1"
+  name        = "Leap day"
+  date        = "2023-02-29"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Date`),
+			},
+		},
+	})
+}
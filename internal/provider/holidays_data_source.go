@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type holidaysDataSource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &holidaysDataSource{}
+	_ datasource.DataSourceWithConfigure = &holidaysDataSource{}
+)
+
+// NewHolidaysDataSource is a helper function to simplify the provider implementation.
+func NewHolidaysDataSource() datasource.DataSource {
+	return &holidaysDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *holidaysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *holidaysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holidays"
+}
+
+// Schema defines the schema for the data source.
+func (d *holidaysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the holidays and other closures of an OnSched location.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the location.",
+				Computed:            true,
+			},
+			"location_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the location.",
+				Required:            true,
+			},
+			"holidays": schema.ListNestedAttribute{
+				MarkdownDescription: "Holidays of the location, ordered by date.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Holiday ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the holiday.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Date of the closure in `YYYY-MM-DD` format.",
+							Computed:            true,
+						},
+						"closed_hours": schema.SingleNestedAttribute{
+							MarkdownDescription: "Part of the day the location is closed. Null when closed for the whole day.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"start_time": schema.StringAttribute{
+									MarkdownDescription: "Start of the closure in 24-hour `HH:MM` format.",
+									Computed:            true,
+								},
+								"end_time": schema.StringAttribute{
+									MarkdownDescription: "End of the closure in 24-hour `HH:MM` format.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *holidaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state holidaysDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	holidays, err := d.client.ListHolidays(ctx, state.LocationID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched holidays", err)
+		return
+	}
+
+	state.ID = state.LocationID
+	state.Holidays = []holidayModel{}
+	for _, holiday := range holidays {
+		if holiday.Deleted {
+			continue
+		}
+		model := holidayModel{
+			ID:   types.StringValue(holiday.ID),
+			Name: types.StringValue(holiday.Name),
+			Date: types.StringValue(formatDate(holiday.Date)),
+		}
+		if !holiday.FullDay() {
+			model.ClosedHours = newDayHoursModel(holiday.ClosedHours())
+		}
+		state.Holidays = append(state.Holidays, model)
+	}
+	sort.SliceStable(state.Holidays, func(i, j int) bool {
+		return state.Holidays[i].Date.ValueString() < state.Holidays[j].Date.ValueString()
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type holidaysDataSourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	LocationID types.String   `tfsdk:"location_id"`
+	Holidays   []holidayModel `tfsdk:"holidays"`
+}
+
+type holidayModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Date        types.String   `tfsdk:"date"`
+	ClosedHours *dayHoursModel `tfsdk:"closed_hours"`
+}
@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type locationResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &locationResource{}
+	_ resource.ResourceWithConfigure   = &locationResource{}
+	_ resource.ResourceWithImportState = &locationResource{}
+)
+
+// NewLocationResource is a helper function to simplify the provider implementation.
+func NewLocationResource() resource.Resource {
+	return &locationResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *locationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *locationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location"
+}
+
+// Schema defines the schema for the resource.
+func (r *locationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A business location of the OnSched company.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Location ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the location.",
+				Required:            true,
+			},
+			"timezone_name": schema.StringAttribute{
+				MarkdownDescription: "IANA timezone of the location, e.g. `America/Toronto`.",
+				Required:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "Phone number of the location.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Contact email address of the location.",
+				Optional:            true,
+			},
+			"website": schema.StringAttribute{
+				MarkdownDescription: "Website of the location.",
+				Optional:            true,
+			},
+			"address": schema.SingleNestedAttribute{
+				MarkdownDescription: "Postal address of the location.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"address_line1": schema.StringAttribute{
+						Optional: true,
+					},
+					"address_line2": schema.StringAttribute{
+						Optional: true,
+					},
+					"city": schema.StringAttribute{
+						Optional: true,
+					},
+					"state": schema.StringAttribute{
+						Optional: true,
+					},
+					"postal_code": schema.StringAttribute{
+						Optional: true,
+					},
+					"country": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Notification settings of the location.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enable_email_notifications": schema.BoolAttribute{
+						MarkdownDescription: "Send email notifications for bookings at this location.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"enable_sms_notifications": schema.BoolAttribute{
+						MarkdownDescription: "Send SMS notifications for bookings at this location.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"business_hours": schema.SingleNestedAttribute{
+				MarkdownDescription: "Opening hours of the location per weekday. " +
+					"Leave unset to manage them with `onsched_business_hours` instead, in which case they are left unchanged.",
+				Optional:   true,
+				Attributes: weeklyHoursAttributes(),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *locationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan locationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	location, err := plan.toLocation()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched location", err.Error())
+		return
+	}
+
+	location, err = r.client.CreateLocation(ctx, location)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched location", err)
+		return
+	}
+
+	plan.refresh(location)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state locationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	location, err := r.client.GetLocation(ctx, state.ID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && location.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched location", err)
+		return
+	}
+
+	// Imported state holds only the ID, so track the nested objects the API
+	// reports values for.
+	if state.Name.IsNull() {
+		state.trackReported(location)
+	}
+
+	state.refresh(location)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *locationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan locationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if _, err := plan.toLocation(); err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched location", err.Error())
+		return
+	}
+
+	// The location is read and saved under the client's lock of the
+	// location, so hours set concurrently by onsched_business_hours are not
+	// lost.
+	location, err := r.client.MutateLocation(ctx, plan.ID.ValueString(), plan.apply)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched location", err)
+		return
+	}
+
+	plan.refresh(location)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *locationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state locationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteLocation(ctx, state.ID.ValueString())
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched location", err)
+		return
+	}
+}
+
+// ImportState imports an existing location by its ID.
+func (r *locationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type locationResourceModel struct {
+	ID            types.String           `tfsdk:"id"`
+	Name          types.String           `tfsdk:"name"`
+	TimezoneName  types.String           `tfsdk:"timezone_name"`
+	Phone         types.String           `tfsdk:"phone"`
+	Email         types.String           `tfsdk:"email"`
+	Website       types.String           `tfsdk:"website"`
+	Address       *addressModel          `tfsdk:"address"`
+	Settings      *locationSettingsModel `tfsdk:"settings"`
+	BusinessHours *weeklyHoursModel      `tfsdk:"business_hours"`
+	Timeouts      timeouts.Value         `tfsdk:"timeouts"`
+}
+
+type addressModel struct {
+	AddressLine1 types.String `tfsdk:"address_line1"`
+	AddressLine2 types.String `tfsdk:"address_line2"`
+	City         types.String `tfsdk:"city"`
+	State        types.String `tfsdk:"state"`
+	PostalCode   types.String `tfsdk:"postal_code"`
+	Country      types.String `tfsdk:"country"`
+}
+
+type locationSettingsModel struct {
+	EnableEmailNotifications types.Bool `tfsdk:"enable_email_notifications"`
+	EnableSmsNotifications   types.Bool `tfsdk:"enable_sms_notifications"`
+}
+
+// toLocation converts the model to the API representation.
+func (m *locationResourceModel) toLocation() (onsched.Location, error) {
+	location := onsched.Location{
+		ID:           m.ID.ValueString(),
+		Name:         m.Name.ValueString(),
+		TimezoneName: m.TimezoneName.ValueString(),
+		Phone:        m.Phone.ValueString(),
+		Email:        m.Email.ValueString(),
+		Website:      m.Website.ValueString(),
+	}
+
+	if m.Address != nil {
+		location.Address = m.Address.toAddress()
+	}
+
+	if m.Settings != nil {
+		location.Settings = onsched.LocationSettings{
+			EnableEmailNotifications: m.Settings.EnableEmailNotifications.ValueBool(),
+			EnableSmsNotifications:   m.Settings.EnableSmsNotifications.ValueBool(),
+		}
+	}
+
+	hours, err := m.BusinessHours.toBusinessHours()
+	if err != nil {
+		return location, fmt.Errorf("business_hours.%w", err)
+	}
+	location.BusinessHours = hours
+
+	return location, nil
+}
+
+// apply copies the planned values onto location. Nested objects that are not
+// configured keep their current value, like the fields the schema doesn't
+// model, so that they are not reset by an update.
+func (m *locationResourceModel) apply(location *onsched.Location) error {
+	planned, err := m.toLocation()
+	if err != nil {
+		return err
+	}
+
+	location.Name = planned.Name
+	location.TimezoneName = planned.TimezoneName
+	location.Phone = planned.Phone
+	location.Email = planned.Email
+	location.Website = planned.Website
+	if m.Address != nil {
+		location.Address = planned.Address
+	}
+	if m.Settings != nil {
+		location.Settings = planned.Settings
+	}
+	if m.BusinessHours != nil {
+		location.BusinessHours = planned.BusinessHours
+	}
+	return nil
+}
+
+// refresh updates the model from the API representation. Nested objects are
+// only tracked when configured, so that values the API fills in for them
+// don't conflict with a null configuration.
+func (m *locationResourceModel) refresh(l onsched.Location) {
+	m.ID = types.StringValue(l.ID)
+	m.Name = types.StringValue(l.Name)
+	m.TimezoneName = types.StringValue(l.TimezoneName)
+	m.Phone = optionalString(l.Phone)
+	m.Email = optionalString(l.Email)
+	m.Website = optionalString(l.Website)
+
+	if m.Address != nil {
+		m.Address = newAddressModel(l.Address)
+	}
+
+	if m.Settings != nil {
+		m.Settings = &locationSettingsModel{
+			EnableEmailNotifications: types.BoolValue(l.Settings.EnableEmailNotifications),
+			EnableSmsNotifications:   types.BoolValue(l.Settings.EnableSmsNotifications),
+		}
+	}
+
+	if m.BusinessHours != nil {
+		m.BusinessHours = newWeeklyHoursModel(l.BusinessHours)
+		if m.BusinessHours == nil {
+			m.BusinessHours = &weeklyHoursModel{}
+		}
+	}
+}
+
+// trackReported starts tracking the address and settings when the API
+// reports values for them. Business hours are left to onsched_business_hours
+// unless configured.
+func (m *locationResourceModel) trackReported(l onsched.Location) {
+	if l.Address != (onsched.Address{}) {
+		m.Address = &addressModel{}
+	}
+	if l.Settings != (onsched.LocationSettings{}) {
+		m.Settings = &locationSettingsModel{}
+	}
+}
+
+func (m *addressModel) toAddress() onsched.Address {
+	return onsched.Address{
+		AddressLine1: m.AddressLine1.ValueString(),
+		AddressLine2: m.AddressLine2.ValueString(),
+		City:         m.City.ValueString(),
+		State:        m.State.ValueString(),
+		PostalCode:   m.PostalCode.ValueString(),
+		Country:      m.Country.ValueString(),
+	}
+}
+
+func newAddressModel(a onsched.Address) *addressModel {
+	return &addressModel{
+		AddressLine1: optionalString(a.AddressLine1),
+		AddressLine2: optionalString(a.AddressLine2),
+		City:         optionalString(a.City),
+		State:        optionalString(a.State),
+		PostalCode:   optionalString(a.PostalCode),
+		Country:      optionalString(a.Country),
+	}
+}
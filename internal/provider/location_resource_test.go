@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLocationResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the address assigned by the API isn't
+			// tracked
+			{
+				Config: providerConfig + `
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Toronto"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("onsched_location.test", "id"),
+					resource.TestCheckResourceAttr("onsched_location.test", "name", "Downtown"),
+					resource.TestCheckNoResourceAttr("onsched_location.test", "address.country"),
+					resource.TestCheckNoResourceAttr("onsched_location.test", "settings.enable_email_notifications"),
+					resource.TestCheckNoResourceAttr("onsched_location.test", "business_hours.mon.start_time"),
+				),
+			},
+			// Updating leaves the values that aren't configured alone
+			{
+				Config: providerConfig + `
+resource "onsched_location" "test" {
+  name          = "Downtown Core"
+  timezone_name = "America/Toronto"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_location.test", "name", "Downtown Core"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["onsched_location.test"].Primary.ID
+						location, _ := server.Location(id)
+						if location.Address.Country != "CA" || !location.Settings.EnableEmailNotifications ||
+							location.FriendlyID == "" || location.TimezoneID == "" {
+							return fmt.Errorf("update reset values that aren't configured: %+v", location)
+						}
+						return nil
+					},
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "onsched_location" "test" {
+  name          = "Downtown Toronto"
+  timezone_name = "America/Toronto"
+  phone         = "4165550100"
+  email         = "downtown@acme.example"
+
+  address = {
+    address_line1 = "1 Yonge Street"
+    city          = "Toronto"
+    state         = "ON"
+    country       = "CA"
+  }
+
+  settings = {
+    enable_email_notifications = true
+  }
+
+  business_hours = {
+    mon = { start_time = "09:00", end_time = "17:00" }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_location.test", "name", "Downtown Toronto"),
+					resource.TestCheckResourceAttr("onsched_location.test", "address.city", "Toronto"),
+					resource.TestCheckNoResourceAttr("onsched_location.test", "address.postal_code"),
+					resource.TestCheckResourceAttr("onsched_location.test", "settings.enable_email_notifications", "true"),
+					resource.TestCheckResourceAttr("onsched_location.test", "settings.enable_sms_notifications", "false"),
+					testAccCheckBusinessHours(server, "onsched_location.test", onsched.BusinessHours{
+						Mon: onsched.DayHours{StartTime: 900, EndTime: 1700},
+					}),
+				),
+			},
+			// ImportState testing, business hours are only tracked when
+			// configured
+			{
+				ResourceName:            "onsched_location.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"business_hours"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"terraform-provider-onsched/onsched"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -44,8 +46,17 @@ const (
 	Prod    Environment = "prod"
 )
 
+// defaultTimeout applies to resource operations without a configured timeout.
+const defaultTimeout = 20 * time.Minute
+
 type onschedProviderModel struct {
-	Env types.String `tfsdk:"env"`
+	Env          types.String `tfsdk:"env"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	APIURL       types.String `tfsdk:"api_url"`
+	TokenURL     types.String `tfsdk:"token_url"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
 }
 
 // Metadata returns the provider type name.
@@ -64,6 +75,43 @@ func (p *OnSchedProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 					stringvalidator.OneOf([]string{"sandbox", "prod"}...),
 				},
 			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OnSched API client ID. May also be provided via the `ONSCHED_CLIENT_ID` environment variable.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "OnSched API client secret. May also be provided via the `ONSCHED_CLIENT_SECRET` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "Overrides the OnSched API base URL derived from `env`. May also be provided via the `ONSCHED_API_URL` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					absoluteURLValidator{},
+				},
+			},
+			"token_url": schema.StringAttribute{
+				MarkdownDescription: "Overrides the OAuth2 token URL derived from `env`. May also be provided via the `ONSCHED_TOKEN_URL` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					absoluteURLValidator{},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request is retried after a transient failure (HTTP 429, 502, 503, 504 or a network error). Only idempotent requests are retried. Defaults to `3`, set to `0` to disable retries.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds to wait between retries, including waits requested through a `Retry-After` header. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -85,43 +133,154 @@ func (p *OnSchedProvider) Configure(ctx context.Context, req provider.ConfigureR
 		env = onsched.Prod
 	}
 
+	if config.ClientID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Unknown OnSched client ID",
+			"The provider cannot create the OnSched API client as there is an unknown configuration value for the client ID. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ONSCHED_CLIENT_ID environment variable.",
+		)
+	}
+
+	if config.ClientSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Unknown OnSched client secret",
+			"The provider cannot create the OnSched API client as there is an unknown configuration value for the client secret. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ONSCHED_CLIENT_SECRET environment variable.",
+		)
+	}
+
+	if config.APIURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Unknown OnSched API URL",
+			"The provider cannot create the OnSched API client as there is an unknown configuration value for the API URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ONSCHED_API_URL environment variable.",
+		)
+	}
+
+	if config.TokenURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_url"),
+			"Unknown OnSched token URL",
+			"The provider cannot create the OnSched API client as there is an unknown configuration value for the token URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ONSCHED_TOKEN_URL environment variable.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configuration values take precedence over the environment.
 	client_id := os.Getenv("ONSCHED_CLIENT_ID")
 	client_secret := os.Getenv("ONSCHED_CLIENT_SECRET")
 
+	if !config.ClientID.IsNull() {
+		client_id = config.ClientID.ValueString()
+	}
+
+	if !config.ClientSecret.IsNull() {
+		client_secret = config.ClientSecret.ValueString()
+	}
+
 	if client_id == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
-			"Missing ONSCHED_CLIENT_ID",
-			"Set the ONSCHED_CLIENT_ID environment variable.",
+			"Missing OnSched client ID",
+			"Set the client_id value in the provider configuration or the ONSCHED_CLIENT_ID environment variable.",
 		)
 	}
 
 	if client_secret == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_secret"),
-			"Missing ONSCHED_CLIENT_SECRET",
-			"Set the ONSCHED_CLIENT_SECRET environment variable.",
+			"Missing OnSched client secret",
+			"Set the client_secret value in the provider configuration or the ONSCHED_CLIENT_SECRET environment variable.",
 		)
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var opts []onsched.Option
+
+	api_url := os.Getenv("ONSCHED_API_URL")
+	if !config.APIURL.IsNull() {
+		api_url = config.APIURL.ValueString()
+	}
+	if api_url != "" {
+		if err := checkAbsoluteURL(api_url); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_url"), "Invalid OnSched API URL", err.Error())
+		}
+		opts = append(opts, onsched.WithAPIURL(api_url))
+	}
+
+	token_url := os.Getenv("ONSCHED_TOKEN_URL")
+	if !config.TokenURL.IsNull() {
+		token_url = config.TokenURL.ValueString()
+	}
+	if token_url != "" {
+		if err := checkAbsoluteURL(token_url); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("token_url"), "Invalid OnSched token URL", err.Error())
+		}
+		opts = append(opts, onsched.WithTokenURL(token_url))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retry := onsched.DefaultRetryPolicy()
+	if !config.MaxRetries.IsNull() {
+		retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryMaxWait.IsNull() {
+		retry.MaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+	if retry.MinWait > retry.MaxWait {
+		retry.MinWait = retry.MaxWait
+	}
+	opts = append(opts, onsched.WithRetryPolicy(retry))
+
 	tflog.Debug(ctx, "Creating OnSched client")
-	client := onsched.NewClient(env, client_id, client_secret)
+	client := onsched.NewClient(env, client_id, client_secret, opts...)
 
+	resp.DataSourceData = client
 	resp.ResourceData = client
 	tflog.Info(ctx, "Configured OnSched client")
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *OnSchedProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewCompanyDataSource,
+		NewHolidaysDataSource,
+		NewResourceAvailabilityDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *OnSchedProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewWebhookResource,
+		NewBookingWebhookResource,
+		NewCustomerWebhookResource,
+		NewReminderWebhookResource,
+		NewResourceWebhookResource,
+		NewWebhookSignatureResource,
+		NewCompanyResource,
+		NewLocationResource,
+		NewBusinessHoursResource,
+		NewHolidayResource,
+		NewServiceResource,
+		NewServiceGroupResource,
+		NewServiceResourceLinkResource,
+		NewServiceAllocationResource,
+		NewResourceAvailabilityResource,
+		NewResourceResource,
+		NewResourceGroupResource,
 	}
 }
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-onsched/internal/onschedtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"onsched": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccServer starts a fake OnSched server for the duration of the test
+// and returns it with the provider configuration targeting it.
+func testAccServer(t *testing.T) (*onschedtest.Server, string) {
+	t.Helper()
+
+	server := onschedtest.NewServer()
+	t.Cleanup(server.Close)
+
+	config := fmt.Sprintf(`
+provider "onsched" {
+  client_id     = %q
+  client_secret = %q
+  api_url       = %q
+  token_url     = %q
+  max_retries   = 0
+}
+`, onschedtest.ClientID, onschedtest.ClientSecret, server.APIURL(), server.TokenURL())
+
+	return server, config
+}
+
+// testAccImportStateID returns the import ID of the named resource, formed
+// by joining the given attributes with "/".
+func testAccImportStateID(name string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+		values := make([]string, len(attributes))
+		for i, attribute := range attributes {
+			values[i] = rs.Primary.Attributes[attribute]
+		}
+		return strings.Join(values, "/"), nil
+	}
+}
+
+func TestAccProvider_invalidURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "onsched" {
+  client_id     = "id"
+  client_secret = "secret"
+  api_url       = "api.onsched.test/"
+}
+
+data "onsched_company" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`is not an absolute http or https URL`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_unknownURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "terraform_data" "token_url" {
+  input = "https://token.onsched.test/connect/token"
+}
+
+provider "onsched" {
+  client_id     = "id"
+  client_secret = "secret"
+  token_url     = terraform_data.token_url.output
+}
+
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Toronto"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unknown OnSched token URL`),
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceAvailabilityDataSource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &resourceAvailabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourceAvailabilityDataSource{}
+)
+
+// NewResourceAvailabilityDataSource is a helper function to simplify the provider implementation.
+func NewResourceAvailabilityDataSource() datasource.DataSource {
+	return &resourceAvailabilityDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *resourceAvailabilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *resourceAvailabilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_availability"
+}
+
+// Schema defines the schema for the data source.
+func (d *resourceAvailabilityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the resource.",
+			Computed:            true,
+		},
+		"resource_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the resource.",
+			Required:            true,
+		},
+	}
+	for _, day := range weekdays {
+		attributes[day] = schema.SingleNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("Bookable hours on %s. Null when the resource can't be booked.", weekdayNames[day]),
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"start_time": schema.StringAttribute{
+					MarkdownDescription: "Start of the bookable hours in 24-hour `HH:MM` format.",
+					Computed:            true,
+				},
+				"end_time": schema.StringAttribute{
+					MarkdownDescription: "End of the bookable hours in 24-hour `HH:MM` format.",
+					Computed:            true,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The effective weekly availability of an OnSched resource: " +
+			"the hours during which the resource is available and its location is open. Holidays are not taken into account.",
+
+		Attributes: attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *resourceAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourceAvailabilityDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	availability, err := d.client.GetEffectiveAvailability(ctx, state.ResourceID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched resource availability", err)
+		return
+	}
+
+	state.ID = state.ResourceID
+	weekly := newWeeklyHoursModel(availability)
+	if weekly == nil {
+		weekly = &weeklyHoursModel{}
+	}
+	state.Mon, state.Tue, state.Wed, state.Thu, state.Fri, state.Sat, state.Sun = weekly.Mon, weekly.Tue, weekly.Wed, weekly.Thu, weekly.Fri, weekly.Sat, weekly.Sun
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type resourceAvailabilityDataSourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	ResourceID types.String   `tfsdk:"resource_id"`
+	Mon        *dayHoursModel `tfsdk:"mon"`
+	Tue        *dayHoursModel `tfsdk:"tue"`
+	Wed        *dayHoursModel `tfsdk:"wed"`
+	Thu        *dayHoursModel `tfsdk:"thu"`
+	Fri        *dayHoursModel `tfsdk:"fri"`
+	Sat        *dayHoursModel `tfsdk:"sat"`
+	Sun        *dayHoursModel `tfsdk:"sun"`
+}
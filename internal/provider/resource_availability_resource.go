@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceAvailabilityResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &resourceAvailabilityResource{}
+	_ resource.ResourceWithConfigure   = &resourceAvailabilityResource{}
+	_ resource.ResourceWithImportState = &resourceAvailabilityResource{}
+)
+
+// NewResourceAvailabilityResource is a helper function to simplify the provider implementation.
+func NewResourceAvailabilityResource() resource.Resource {
+	return &resourceAvailabilityResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceAvailabilityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *resourceAvailabilityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_availability"
+}
+
+// Schema defines the schema for the resource.
+func (r *resourceAvailabilityResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := weeklyHoursAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the resource the availability belongs to.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["resource_id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the resource.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The weekly availability of an OnSched resource, e.g. the shifts of a staff member. " +
+			"Resources can only be booked while they are available and their location is open, " +
+			"see the `onsched_resource_availability` data source for the resulting hours. " +
+			"Destroying the resource makes the resource unavailable on every day.",
+
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *resourceAvailabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan resourceAvailabilityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	availability, err := plan.weeklyHours().toBusinessHours()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched resource availability", err.Error())
+		return
+	}
+
+	availability, err = r.client.UpdateResourceAvailability(ctx, plan.ResourceID.ValueString(), availability)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched resource availability", err)
+		return
+	}
+
+	plan.ID = plan.ResourceID
+	plan.refresh(availability)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *resourceAvailabilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceAvailabilityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, err := r.client.GetResource(ctx, state.ResourceID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && res.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched resource availability", err)
+		return
+	}
+
+	state.ID = types.StringValue(res.ID)
+	state.ResourceID = types.StringValue(res.ID)
+	state.refresh(res.Availability)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *resourceAvailabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan resourceAvailabilityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	availability, err := plan.weeklyHours().toBusinessHours()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched resource availability", err.Error())
+		return
+	}
+
+	availability, err = r.client.UpdateResourceAvailability(ctx, plan.ResourceID.ValueString(), availability)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched resource availability", err)
+		return
+	}
+
+	plan.refresh(availability)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete makes the resource unavailable on every day.
+func (r *resourceAvailabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state resourceAvailabilityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.UpdateResourceAvailability(ctx, state.ResourceID.ValueString(), onsched.BusinessHours{})
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched resource availability", err)
+		return
+	}
+}
+
+// ImportState imports the availability of the resource with the given ID.
+func (r *resourceAvailabilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("resource_id"), req, resp)
+}
+
+type resourceAvailabilityResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	ResourceID types.String   `tfsdk:"resource_id"`
+	Mon        *dayHoursModel `tfsdk:"mon"`
+	Tue        *dayHoursModel `tfsdk:"tue"`
+	Wed        *dayHoursModel `tfsdk:"wed"`
+	Thu        *dayHoursModel `tfsdk:"thu"`
+	Fri        *dayHoursModel `tfsdk:"fri"`
+	Sat        *dayHoursModel `tfsdk:"sat"`
+	Sun        *dayHoursModel `tfsdk:"sun"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// weeklyHours returns the configured days as a weekly schedule.
+func (m *resourceAvailabilityResourceModel) weeklyHours() *weeklyHoursModel {
+	return &weeklyHoursModel{
+		Mon: m.Mon,
+		Tue: m.Tue,
+		Wed: m.Wed,
+		Thu: m.Thu,
+		Fri: m.Fri,
+		Sat: m.Sat,
+		Sun: m.Sun,
+	}
+}
+
+// refresh updates the days from the API representation.
+func (m *resourceAvailabilityResourceModel) refresh(availability onsched.BusinessHours) {
+	weekly := newWeeklyHoursModel(availability)
+	if weekly == nil {
+		weekly = &weeklyHoursModel{}
+	}
+	m.Mon, m.Tue, m.Wed, m.Thu, m.Fri, m.Sat, m.Sun = weekly.Mon, weekly.Tue, weekly.Wed, weekly.Thu, weekly.Fri, weekly.Sat, weekly.Sun
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-onsched/internal/onschedtest"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResourceAvailabilityResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	const base = `
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Toronto"
+
+  business_hours = {
+    mon = { start_time = "09:00", end_time = "17:00" }
+    tue = { start_time = "09:00", end_time = "17:00" }
+    sat = { start_time = "10:00", end_time = "14:00" }
+  }
+}
+
+resource "onsched_resource" "test" {
+  name        = "Jane Doe"
+  location_id = onsched_location.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + base + `
+resource "onsched_resource_availability" "test" {
+  resource_id = onsched_resource.test.id
+
+  mon = { start_time = "08:00", end_time = "12:00" }
+  tue = { start_time = "13:00", end_time = "20:00" }
+  wed = { start_time = "09:00", end_time = "17:00" }
+  sat = { start_time = "14:00", end_time = "18:00" }
+}
+
+data "onsched_resource_availability" "test" {
+  resource_id = onsched_resource_availability.test.resource_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("onsched_resource_availability.test", "id", "onsched_resource.test", "id"),
+					resource.TestCheckResourceAttr("onsched_resource_availability.test", "mon.start_time", "08:00"),
+					resource.TestCheckNoResourceAttr("onsched_resource_availability.test", "thu.start_time"),
+					testAccCheckResourceAvailability(server, "onsched_resource.test", onsched.BusinessHours{
+						Mon: onsched.DayHours{StartTime: 800, EndTime: 1200},
+						Tue: onsched.DayHours{StartTime: 1300, EndTime: 2000},
+						Wed: onsched.DayHours{StartTime: 900, EndTime: 1700},
+						Sat: onsched.DayHours{StartTime: 1400, EndTime: 1800},
+					}),
+					// Only the hours during which the location is open remain.
+					resource.TestCheckResourceAttr("data.onsched_resource_availability.test", "mon.start_time", "09:00"),
+					resource.TestCheckResourceAttr("data.onsched_resource_availability.test", "mon.end_time", "12:00"),
+					resource.TestCheckResourceAttr("data.onsched_resource_availability.test", "tue.start_time", "13:00"),
+					resource.TestCheckResourceAttr("data.onsched_resource_availability.test", "tue.end_time", "17:00"),
+					resource.TestCheckNoResourceAttr("data.onsched_resource_availability.test", "wed.start_time"),
+					resource.TestCheckNoResourceAttr("data.onsched_resource_availability.test", "sat.start_time"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_resource_availability.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, the resource keeps its availability
+			{
+				Config: providerConfig + `
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Toronto"
+
+  business_hours = {
+    mon = { start_time = "09:00", end_time = "17:00" }
+    tue = { start_time = "09:00", end_time = "17:00" }
+    sat = { start_time = "10:00", end_time = "14:00" }
+  }
+}
+
+resource "onsched_resource" "test" {
+  name        = "Jane Smith"
+  location_id = onsched_location.test.id
+}
+
+resource "onsched_resource_availability" "test" {
+  resource_id = onsched_resource.test.id
+
+  sat = { start_time = "10:00", end_time = "12:00" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_resource.test", "name", "Jane Smith"),
+					resource.TestCheckNoResourceAttr("onsched_resource_availability.test", "mon.start_time"),
+					testAccCheckResourceAvailability(server, "onsched_resource.test", onsched.BusinessHours{
+						Sat: onsched.DayHours{StartTime: 1000, EndTime: 1200},
+					}),
+				),
+			},
+			// Delete makes the resource unavailable
+			{
+				Config: providerConfig + base,
+				Check:  testAccCheckResourceAvailability(server, "onsched_resource.test", onsched.BusinessHours{}),
+			},
+		},
+	})
+}
+
+func TestAccResourceAvailabilityResource_invalidRange(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "onsched_resource_availability" "test" {
+  resource_id = "resource-1"
+
+  thu = { start_time = "18:00", end_time = "08:00" }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Time Range`),
+			},
+		},
+	})
+}
+
+// testAccCheckResourceAvailability verifies the availability stored by the
+// server for the resource with the given name.
+func testAccCheckResourceAvailability(server *onschedtest.Server, name string, want onsched.BusinessHours) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+		res, ok := server.Resource(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("resource %s not found", rs.Primary.ID)
+		}
+		if res.Availability != want {
+			return fmt.Errorf("availability = %+v, want %+v", res.Availability, want)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceGroupResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &resourceGroupResource{}
+	_ resource.ResourceWithConfigure   = &resourceGroupResource{}
+	_ resource.ResourceWithImportState = &resourceGroupResource{}
+)
+
+// NewResourceGroupResource is a helper function to simplify the provider implementation.
+func NewResourceGroupResource() resource.Resource {
+	return &resourceGroupResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *resourceGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *resourceGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A group of OnSched resources, e.g. the staff of a team, and its members.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource group ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the resource group.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the resource group.",
+				Optional:            true,
+			},
+			"resource_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the resources in the group. Resources not listed are removed from the group. " +
+					"Leave unset to manage membership outside of Terraform.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *resourceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan resourceGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	group, err := r.client.CreateResourceGroup(ctx, plan.toResourceGroup())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched resource group", err)
+		return
+	}
+
+	plan.refresh(group)
+
+	// Save the group with its current members before adding the planned ones
+	// so that it isn't lost if that fails.
+	created := plan
+	if !created.ResourceIDs.IsNull() {
+		resp.Diagnostics.Append(r.readMembers(ctx, &created)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	diags = resp.State.Set(ctx, created)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateMembers(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *resourceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	group, err := r.client.GetResourceGroup(ctx, state.ID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && group.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched resource group", err)
+		return
+	}
+
+	state.refresh(group)
+
+	if !state.ResourceIDs.IsNull() {
+		resp.Diagnostics.Append(r.readMembers(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *resourceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan resourceGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	group, err := r.client.UpdateResourceGroup(ctx, plan.toResourceGroup())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched resource group", err)
+		return
+	}
+
+	plan.refresh(group)
+
+	resp.Diagnostics.Append(r.updateMembers(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *resourceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state resourceGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteResourceGroup(ctx, state.ID.ValueString())
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched resource group", err)
+		return
+	}
+}
+
+// ImportState imports an existing resource group by its ID.
+func (r *resourceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateMembers makes the resources listed in the model the only members of
+// the group and refreshes the model with the resulting members. Nothing is
+// done when membership isn't managed.
+func (r *resourceGroupResource) updateMembers(ctx context.Context, m *resourceGroupResourceModel) diag.Diagnostics {
+	if m.ResourceIDs.IsNull() {
+		return nil
+	}
+
+	var want []string
+	diags := m.ResourceIDs.ElementsAs(ctx, &want, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	members, err := r.client.GetResourceGroupMembers(ctx, m.ID.ValueString())
+	if err != nil {
+		addClientError(&diags, "Error reading OnSched resource group members", err)
+		return diags
+	}
+
+	remove := make(map[string]bool, len(members))
+	for _, member := range members {
+		remove[member.ID] = true
+	}
+	for _, id := range want {
+		if remove[id] {
+			delete(remove, id)
+			continue
+		}
+		if err := r.client.AddResourceGroupMember(ctx, m.ID.ValueString(), id); err != nil {
+			addClientError(&diags, fmt.Sprintf("Error adding resource %s to OnSched resource group", id), err)
+			return diags
+		}
+	}
+	for _, id := range sortedKeys(remove) {
+		if err := r.client.RemoveResourceGroupMember(ctx, m.ID.ValueString(), id); err != nil && !onsched.IsNotFound(err) {
+			addClientError(&diags, fmt.Sprintf("Error removing resource %s from OnSched resource group", id), err)
+			return diags
+		}
+	}
+
+	diags.Append(r.readMembers(ctx, m)...)
+	return diags
+}
+
+// readMembers refreshes the members of the group in the model.
+func (r *resourceGroupResource) readMembers(ctx context.Context, m *resourceGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members, err := r.client.GetResourceGroupMembers(ctx, m.ID.ValueString())
+	if err != nil {
+		addClientError(&diags, "Error reading OnSched resource group members", err)
+		return diags
+	}
+
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = member.ID
+	}
+	m.ResourceIDs, diags = types.SetValueFrom(ctx, types.StringType, ids)
+	return diags
+}
+
+type resourceGroupResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	ResourceIDs types.Set      `tfsdk:"resource_ids"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toResourceGroup converts the model to the API representation.
+func (m *resourceGroupResourceModel) toResourceGroup() onsched.ResourceGroup {
+	return onsched.ResourceGroup{
+		ID:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// refresh updates the model from the API representation.
+func (m *resourceGroupResourceModel) refresh(g onsched.ResourceGroup) {
+	m.ID = types.StringValue(g.ID)
+	m.Name = types.StringValue(g.Name)
+	m.Description = optionalString(g.Description)
+}
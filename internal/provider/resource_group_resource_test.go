@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"terraform-provider-onsched/internal/onschedtest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResourceGroupResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	const resources = `
+resource "onsched_resource" "jane" {
+  name = "Jane Doe"
+}
+
+resource "onsched_resource" "john" {
+  name = "John Doe"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + resources + `
+resource "onsched_resource_group" "test" {
+  name         = "Therapists"
+  resource_ids = [onsched_resource.jane.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("onsched_resource_group.test", "id"),
+					resource.TestCheckResourceAttr("onsched_resource_group.test", "resource_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("onsched_resource_group.test", "resource_ids.*", "onsched_resource.jane", "id"),
+					testAccCheckResourceGroupMembers(server, "onsched_resource_group.test", "onsched_resource.jane"),
+				),
+			},
+			// ImportState testing, membership isn't managed after import
+			{
+				ResourceName:            "onsched_resource_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_ids"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + resources + `
+resource "onsched_resource_group" "test" {
+  name         = "Massage Therapists"
+  description  = "Registered massage therapists"
+  resource_ids = [onsched_resource.john.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_resource_group.test", "name", "Massage Therapists"),
+					resource.TestCheckResourceAttr("onsched_resource_group.test", "resource_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("onsched_resource_group.test", "resource_ids.*", "onsched_resource.john", "id"),
+					testAccCheckResourceGroupMembers(server, "onsched_resource_group.test", "onsched_resource.john"),
+				),
+			},
+			// Unmanaged membership is left unchanged
+			{
+				Config: providerConfig + resources + `
+resource "onsched_resource_group" "test" {
+  name = "Massage Therapists"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("onsched_resource_group.test", "resource_ids.#"),
+					testAccCheckResourceGroupMembers(server, "onsched_resource_group.test", "onsched_resource.john"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckResourceGroupMembers verifies that the members of the group
+// stored by the server are exactly the given resources.
+func testAccCheckResourceGroupMembers(server *onschedtest.Server, group string, members ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[group]
+		if !ok {
+			return fmt.Errorf("resource %s not found", group)
+		}
+
+		want := make([]string, len(members))
+		for i, member := range members {
+			ms, ok := s.RootModule().Resources[member]
+			if !ok {
+				return fmt.Errorf("resource %s not found", member)
+			}
+			want[i] = ms.Primary.ID
+		}
+
+		if got := server.ResourceGroupMembers(rs.Primary.ID); !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("members = %q, want %q", got, want)
+		}
+		return nil
+	}
+}
+
+func TestAccResourceGroupResource_addMemberError(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The group is kept, tainted and without the member that couldn't be added.
+			{
+				Config: providerConfig + `
+resource "onsched_resource_group" "test" {
+  name         = "Therapists"
+  resource_ids = ["does-not-exist"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Error adding resource does-not-exist`),
+			},
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_resource_group.test", "resource_ids.#", "0"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["onsched_resource_group.test"].Primary.ID
+						if members := server.ResourceGroupMembers(id); len(members) != 0 {
+							return fmt.Errorf("members = %q, want none", members)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
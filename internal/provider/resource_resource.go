@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &resourceResource{}
+	_ resource.ResourceWithConfigure   = &resourceResource{}
+	_ resource.ResourceWithImportState = &resourceResource{}
+)
+
+// NewResourceResource is a helper function to simplify the provider implementation.
+func NewResourceResource() resource.Resource {
+	return &resourceResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *resourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+// Schema defines the schema for the resource.
+func (r *resourceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A bookable OnSched resource such as a staff member, room or piece of equipment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the location the resource belongs to. Defaults to the primary location when unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the resource.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description shown to customers.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the resource, used for notifications.",
+				Optional:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "Business phone number of the resource.",
+				Optional:            true,
+			},
+			"mobile_phone": schema.StringAttribute{
+				MarkdownDescription: "Mobile phone number of the resource, used for SMS notifications.",
+				Optional:            true,
+			},
+			"timezone_name": schema.StringAttribute{
+				MarkdownDescription: "IANA timezone of the resource, e.g. `America/Toronto`. Defaults to the timezone of the location when unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_type": schema.StringAttribute{
+				MarkdownDescription: "Channels the resource is notified through, one of `none`, `email`, `sms` or `email_and_sms`. Defaults to `none`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(notificationTypeNames...),
+				},
+			},
+			"booking_notification": schema.BoolAttribute{
+				MarkdownDescription: "Notify the resource when one of its appointments is booked, rescheduled or cancelled.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *resourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan resourceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	res, err := r.client.CreateResource(ctx, plan.toResource())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched resource", err)
+		return
+	}
+
+	plan.refresh(res)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *resourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res, err := r.client.GetResource(ctx, state.ID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && res.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched resource", err)
+		return
+	}
+
+	state.refresh(res)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *resourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan resourceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Keep the availability managed by onsched_resource_availability. The
+	// resource is read and saved under the client's lock of the resource, so
+	// availability set concurrently is not lost.
+	res, err := r.client.MutateResource(ctx, plan.ID.ValueString(), func(current *onsched.Resource) error {
+		updated := plan.toResource()
+		updated.Availability = current.Availability
+		*current = updated
+		return nil
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched resource", err)
+		return
+	}
+
+	plan.refresh(res)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *resourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state resourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteResource(ctx, state.ID.ValueString())
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched resource", err)
+		return
+	}
+}
+
+// ImportState imports an existing resource by its ID.
+func (r *resourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type resourceResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	LocationID          types.String   `tfsdk:"location_id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	Email               types.String   `tfsdk:"email"`
+	Phone               types.String   `tfsdk:"phone"`
+	MobilePhone         types.String   `tfsdk:"mobile_phone"`
+	TimezoneName        types.String   `tfsdk:"timezone_name"`
+	NotificationType    types.String   `tfsdk:"notification_type"`
+	BookingNotification types.Bool     `tfsdk:"booking_notification"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// notificationTypeNames holds the attribute value of each
+// onsched.NotificationType, indexed by its numeric value.
+var notificationTypeNames = []string{"none", "email", "sms", "email_and_sms"}
+
+// toResource converts the model to the API representation.
+func (m *resourceResourceModel) toResource() onsched.Resource {
+	res := onsched.Resource{
+		ID:                  m.ID.ValueString(),
+		LocationID:          m.LocationID.ValueString(),
+		Name:                m.Name.ValueString(),
+		Description:         m.Description.ValueString(),
+		Email:               m.Email.ValueString(),
+		Phone:               m.Phone.ValueString(),
+		MobilePhone:         m.MobilePhone.ValueString(),
+		TimezoneName:        m.TimezoneName.ValueString(),
+		BookingNotification: m.BookingNotification.ValueBool(),
+	}
+
+	for i, name := range notificationTypeNames {
+		if m.NotificationType.ValueString() == name {
+			res.NotificationType = onsched.NotificationType(i)
+		}
+	}
+
+	return res
+}
+
+// refresh updates the model from the API representation.
+func (m *resourceResourceModel) refresh(res onsched.Resource) {
+	m.ID = types.StringValue(res.ID)
+	m.LocationID = types.StringValue(res.LocationID)
+	m.Name = types.StringValue(res.Name)
+	m.Description = optionalString(res.Description)
+	m.Email = optionalString(res.Email)
+	m.Phone = optionalString(res.Phone)
+	m.MobilePhone = optionalString(res.MobilePhone)
+	m.TimezoneName = types.StringValue(res.TimezoneName)
+	m.BookingNotification = types.BoolValue(res.BookingNotification)
+
+	m.NotificationType = types.StringValue(notificationTypeNames[0])
+	if int(res.NotificationType) >= 0 && int(res.NotificationType) < len(notificationTypeNames) {
+		m.NotificationType = types.StringValue(notificationTypeNames[res.NotificationType])
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceResource(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	const location = `
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Vancouver"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + location + `
+resource "onsched_resource" "test" {
+  name = "Jane Doe"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("onsched_resource.test", "id"),
+					resource.TestCheckResourceAttr("onsched_resource.test", "timezone_name", "America/Toronto"),
+					resource.TestCheckResourceAttr("onsched_resource.test", "notification_type", "none"),
+					resource.TestCheckResourceAttr("onsched_resource.test", "booking_notification", "false"),
+					resource.TestCheckNoResourceAttr("onsched_resource.test", "email"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + location + `
+resource "onsched_resource" "test" {
+  name                 = "Jane Doe"
+  description          = "Registered massage therapist"
+  location_id          = onsched_location.test.id
+  timezone_name        = "America/Vancouver"
+  email                = "jane@acme.example"
+  mobile_phone         = "6045550103"
+  notification_type    = "email_and_sms"
+  booking_notification = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("onsched_resource.test", "location_id", "onsched_location.test", "id"),
+					resource.TestCheckResourceAttr("onsched_resource.test", "timezone_name", "America/Vancouver"),
+					resource.TestCheckResourceAttr("onsched_resource.test", "email", "jane@acme.example"),
+					resource.TestCheckResourceAttr("onsched_resource.test", "notification_type", "email_and_sms"),
+					resource.TestCheckResourceAttr("onsched_resource.test", "booking_notification", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,554 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-onsched/onsched"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// repeatFrequencies maps the repeat frequencies of the schema to the API.
+var repeatFrequencies = map[string]string{
+	"daily":   onsched.RepeatDaily,
+	"weekly":  onsched.RepeatWeekly,
+	"monthly": onsched.RepeatMonthly,
+}
+
+// weekdayDigits maps weekdays to the digits used by the API.
+var weekdayDigits = map[string]string{
+	"sun": "0",
+	"mon": "1",
+	"tue": "2",
+	"wed": "3",
+	"thu": "4",
+	"fri": "5",
+	"sat": "6",
+}
+
+type serviceAllocationResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &serviceAllocationResource{}
+	_ resource.ResourceWithConfigure      = &serviceAllocationResource{}
+	_ resource.ResourceWithImportState    = &serviceAllocationResource{}
+	_ resource.ResourceWithValidateConfig = &serviceAllocationResource{}
+)
+
+// NewServiceAllocationResource is a helper function to simplify the provider implementation.
+func NewServiceAllocationResource() resource.Resource {
+	return &serviceAllocationResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceAllocationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *serviceAllocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_allocation"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceAllocationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A block of time during which an OnSched service can be booked, e.g. the schedule of a class. " +
+			"Allocations happen once on `start_date`, or repeat when `repeat` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Allocation ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the service offered.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the location the allocation applies to. Applies to all locations of the service when unset.",
+				Optional:            true,
+			},
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource performing the service during the allocation.",
+				Optional:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Date of the allocation, or of its first occurrence when repeating, in `YYYY-MM-DD` format.",
+				Required:            true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Last date a repeating allocation occurs on in `YYYY-MM-DD` format. " +
+					"Repeats indefinitely when unset. Only valid with `repeat`.",
+				Optional: true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Start of the allocation in 24-hour `HH:MM` format.",
+				Required:            true,
+				Validators:          clockValidators(),
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "End of the allocation in 24-hour `HH:MM` format.",
+				Required:            true,
+				Validators:          clockValidators(),
+			},
+			"capacity": schema.Int64Attribute{
+				MarkdownDescription: "Number of attendees each time slot accepts.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"booking_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of bookings for the allocation, `0` for no limit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"repeat": schema.SingleNestedAttribute{
+				MarkdownDescription: "Recurrence of the allocation. Leave unset for a one-off allocation.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"frequency": schema.StringAttribute{
+						MarkdownDescription: "How often the allocation repeats: `daily`, `weekly` or `monthly`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("daily", "weekly", "monthly"),
+						},
+					},
+					"interval": schema.Int64Attribute{
+						MarkdownDescription: "Number of days, weeks or months between occurrences.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"weekdays": schema.SetAttribute{
+						MarkdownDescription: "Days a weekly allocation occurs on, e.g. `[\"mon\", \"wed\"]`. Required for weekly allocations.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig checks the attributes that depend on each other.
+func (r *serviceAllocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var startDate, endDate, startTime, endTime types.String
+	var repeat types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_date"), &startDate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end_date"), &endDate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_time"), &startTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end_time"), &endTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("repeat"), &repeat)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Malformed values are left to the attribute validators.
+	if start, err := parseClock(startTime.ValueString()); err == nil {
+		if end, err := parseClock(endTime.ValueString()); err == nil && start >= end {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_time"),
+				"Invalid Time Range",
+				fmt.Sprintf("end_time %s must be after start_time %s.", endTime.ValueString(), startTime.ValueString()),
+			)
+		}
+	}
+
+	if !endDate.IsNull() && !endDate.IsUnknown() {
+		if repeat.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_date"),
+				"Invalid Attribute Combination",
+				"end_date can only be set for repeating allocations, add a repeat block or remove end_date.",
+			)
+		}
+		start, startErr := time.Parse(dateLayout, startDate.ValueString())
+		end, endErr := time.Parse(dateLayout, endDate.ValueString())
+		if startErr == nil && endErr == nil && end.Before(start) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_date"),
+				"Invalid Date Range",
+				fmt.Sprintf("end_date %s must not be before start_date %s.", endDate.ValueString(), startDate.ValueString()),
+			)
+		}
+	}
+
+	if repeat.IsNull() || repeat.IsUnknown() {
+		return
+	}
+
+	var frequency types.String
+	var days types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("repeat").AtName("frequency"), &frequency)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("repeat").AtName("weekdays"), &days)...)
+	if resp.Diagnostics.HasError() || frequency.IsUnknown() {
+		return
+	}
+
+	switch {
+	case frequency.ValueString() == "weekly" && days.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("repeat").AtName("weekdays"),
+			"Missing Attribute Configuration",
+			"weekdays must be set for weekly allocations.",
+		)
+	case frequency.ValueString() != "weekly" && !days.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("repeat").AtName("weekdays"),
+			"Invalid Attribute Combination",
+			"weekdays can only be set for weekly allocations.",
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serviceAllocationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	allocation, err := plan.toAllocation(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched service allocation", err.Error())
+		return
+	}
+
+	allocation, err = r.client.CreateServiceAllocation(ctx, allocation)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched service allocation", err)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refresh(ctx, allocation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceAllocationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	allocation, err := r.client.GetServiceAllocation(ctx, state.ServiceID.ValueString(), state.ID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && allocation.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched service allocation", err)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(ctx, allocation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan serviceAllocationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	allocation, err := plan.toAllocation(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OnSched service allocation", err.Error())
+		return
+	}
+
+	allocation, err = r.client.UpdateServiceAllocation(ctx, allocation)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched service allocation", err)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refresh(ctx, allocation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceAllocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serviceAllocationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteServiceAllocation(ctx, state.ServiceID.ValueString(), state.ID.ValueString())
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched service allocation", err)
+		return
+	}
+}
+
+// ImportState imports an existing allocation by "<service_id>/<allocation_id>".
+func (r *serviceAllocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || serviceID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form <service_id>/<allocation_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+type serviceAllocationResourceModel struct {
+	ID           types.String           `tfsdk:"id"`
+	ServiceID    types.String           `tfsdk:"service_id"`
+	LocationID   types.String           `tfsdk:"location_id"`
+	ResourceID   types.String           `tfsdk:"resource_id"`
+	StartDate    types.String           `tfsdk:"start_date"`
+	EndDate      types.String           `tfsdk:"end_date"`
+	StartTime    types.String           `tfsdk:"start_time"`
+	EndTime      types.String           `tfsdk:"end_time"`
+	Capacity     types.Int64            `tfsdk:"capacity"`
+	BookingLimit types.Int64            `tfsdk:"booking_limit"`
+	Repeat       *allocationRepeatModel `tfsdk:"repeat"`
+	Timeouts     timeouts.Value         `tfsdk:"timeouts"`
+}
+
+type allocationRepeatModel struct {
+	Frequency types.String `tfsdk:"frequency"`
+	Interval  types.Int64  `tfsdk:"interval"`
+	Weekdays  types.Set    `tfsdk:"weekdays"`
+}
+
+// toAllocation converts the model to the API representation.
+func (m *serviceAllocationResourceModel) toAllocation(ctx context.Context) (onsched.ServiceAllocation, error) {
+	allocation := onsched.ServiceAllocation{
+		ID:           m.ID.ValueString(),
+		ServiceID:    m.ServiceID.ValueString(),
+		LocationID:   m.LocationID.ValueString(),
+		ResourceID:   m.ResourceID.ValueString(),
+		StartDate:    m.StartDate.ValueString(),
+		EndDate:      m.EndDate.ValueString(),
+		Capacity:     int(m.Capacity.ValueInt64()),
+		BookingLimit: int(m.BookingLimit.ValueInt64()),
+	}
+
+	hours, err := (&dayHoursModel{StartTime: m.StartTime, EndTime: m.EndTime}).toDayHours()
+	if err != nil {
+		return allocation, err
+	}
+	allocation.StartTime = hours.StartTime
+	allocation.EndTime = hours.EndTime
+
+	if m.Repeat == nil {
+		// A one-off allocation ends on the day it starts.
+		allocation.EndDate = allocation.StartDate
+		return allocation, nil
+	}
+
+	var days []string
+	if diags := m.Repeat.Weekdays.ElementsAs(ctx, &days, false); diags.HasError() {
+		return allocation, fmt.Errorf("repeat.weekdays: invalid value")
+	}
+	digits := make([]string, len(days))
+	for i, day := range days {
+		digits[i] = weekdayDigits[day]
+	}
+	sort.Strings(digits)
+
+	allocation.Repeats = true
+	allocation.Repeat = onsched.AllocationRepeat{
+		Frequency: repeatFrequencies[m.Repeat.Frequency.ValueString()],
+		Interval:  int(m.Repeat.Interval.ValueInt64()),
+		Weekdays:  strings.Join(digits, ""),
+	}
+	return allocation, nil
+}
+
+// refresh updates the model from the API representation.
+func (m *serviceAllocationResourceModel) refresh(ctx context.Context, a onsched.ServiceAllocation) diag.Diagnostics {
+	m.ID = types.StringValue(a.ID)
+	m.ServiceID = types.StringValue(a.ServiceID)
+	m.LocationID = optionalString(a.LocationID)
+	m.ResourceID = optionalString(a.ResourceID)
+	m.StartDate = types.StringValue(formatDate(a.StartDate))
+	m.StartTime = types.StringValue(formatClock(a.StartTime))
+	m.EndTime = types.StringValue(formatClock(a.EndTime))
+	m.Capacity = types.Int64Value(int64(a.Capacity))
+	m.BookingLimit = types.Int64Value(int64(a.BookingLimit))
+
+	if !a.Repeats {
+		m.EndDate = types.StringNull()
+		m.Repeat = nil
+		return nil
+	}
+
+	m.EndDate = optionalString(formatDate(a.EndDate))
+
+	frequency := types.StringNull()
+	for name, value := range repeatFrequencies {
+		if value == a.Repeat.Frequency {
+			frequency = types.StringValue(name)
+		}
+	}
+
+	days := types.SetNull(types.StringType)
+	if a.Repeat.Weekdays != "" {
+		var names []string
+		for _, day := range weekdays {
+			if strings.Contains(a.Repeat.Weekdays, weekdayDigits[day]) {
+				names = append(names, day)
+			}
+		}
+		var diags diag.Diagnostics
+		days, diags = types.SetValueFrom(ctx, types.StringType, names)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	m.Repeat = &allocationRepeatModel{
+		Frequency: frequency,
+		Interval:  types.Int64Value(int64(a.Repeat.Interval)),
+		Weekdays:  days,
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceAllocationResource(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	const service = `
+resource "onsched_service" "test" {
+  name     = "Yoga class"
+  duration = 60
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + service + `
+resource "onsched_service_allocation" "workshop" {
+  service_id = onsched_service.test.id
+  start_date = "2024-03-02"
+  start_time = "13:00"
+  end_time   = "16:00"
+  capacity   = 20
+}
+
+resource "onsched_service_allocation" "weekly" {
+  service_id    = onsched_service.test.id
+  start_date    = "2024-01-08"
+  end_date      = "2024-06-28"
+  start_time    = "09:00"
+  end_time      = "10:30"
+  capacity      = 12
+  booking_limit = 100
+
+  repeat = {
+    frequency = "weekly"
+    weekdays  = ["mon", "wed", "fri"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("onsched_service_allocation.workshop", "id"),
+					resource.TestCheckNoResourceAttr("onsched_service_allocation.workshop", "end_date"),
+					resource.TestCheckNoResourceAttr("onsched_service_allocation.workshop", "repeat.frequency"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.workshop", "booking_limit", "0"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.weekly", "end_date", "2024-06-28"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.weekly", "repeat.interval", "1"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.weekly", "repeat.weekdays.#", "3"),
+					resource.TestCheckTypeSetElemAttr("onsched_service_allocation.weekly", "repeat.weekdays.*", "wed"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_service_allocation.weekly",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateID("onsched_service_allocation.weekly", "service_id", "id"),
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: providerConfig + service + `
+resource "onsched_service_allocation" "workshop" {
+  service_id = onsched_service.test.id
+  start_date = "2024-03-02"
+  start_time = "13:00"
+  end_time   = "16:00"
+  capacity   = 20
+
+  repeat = {
+    frequency = "monthly"
+    interval  = 3
+  }
+}
+
+resource "onsched_service_allocation" "weekly" {
+  service_id = onsched_service.test.id
+  start_date = "2024-01-08"
+  start_time = "18:00"
+  end_time   = "19:30"
+  capacity   = 12
+
+  repeat = {
+    frequency = "weekly"
+    weekdays  = ["tue"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_service_allocation.workshop", "repeat.frequency", "monthly"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.workshop", "repeat.interval", "3"),
+					resource.TestCheckNoResourceAttr("onsched_service_allocation.workshop", "repeat.weekdays"),
+					resource.TestCheckNoResourceAttr("onsched_service_allocation.weekly", "end_date"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.weekly", "start_time", "18:00"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.weekly", "booking_limit", "0"),
+					resource.TestCheckResourceAttr("onsched_service_allocation.weekly", "repeat.weekdays.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccServiceAllocationResource_invalidConfig(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	tests := map[string]struct {
+		config string
+		err    string
+	}{
+		"time range": {
+			config: `
+  start_date = "2024-01-08"
+  start_time = "10:00"
+  end_time   = "09:00"
+`,
+			err: `Invalid Time Range`,
+		},
+		"date range": {
+			config: `
+  start_date = "2024-01-08"
+  end_date   = "2024-01-01"
+  start_time = "09:00"
+  end_time   = "10:00"
+
+  repeat = {
+    frequency = "daily"
+  }
+`,
+			err: `Invalid Date Range`,
+		},
+		"end date without repeat": {
+			config: `
+  start_date = "2024-01-08"
+  end_date   = "2024-01-31"
+  start_time = "09:00"
+  end_time   = "10:00"
+`,
+			err: `end_date can only be set for repeating allocations`,
+		},
+		"weekly without weekdays": {
+			config: `
+  start_date = "2024-01-08"
+  start_time = "09:00"
+  end_time   = "10:00"
+
+  repeat = {
+    frequency = "weekly"
+  }
+`,
+			err: `weekdays must be set for weekly allocations`,
+		},
+		"weekdays without weekly": {
+			config: `
+  start_date = "2024-01-08"
+  start_time = "09:00"
+  end_time   = "10:00"
+
+  repeat = {
+    frequency = "daily"
+    weekdays  = ["mon"]
+  }
+`,
+			err: `weekdays can only be set for weekly allocations`,
+		},
+		"date format": {
+			config: `
+  start_date = "08/01/2024"
+  start_time = "09:00"
+  end_time   = "10:00"
+`,
+			err: `Invalid Date`,
+		},
+		"time format": {
+			config: `
+  start_date = "2024-01-08"
+  start_time = "9am"
+  end_time   = "10:00"
+`,
+			err: `must be a 24-hour time in HH:MM format`,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + `
+resource "onsched_service_allocation" "test" {
+  service_id = "service-1"
+` + test.config + `}
+`,
+						ExpectError: regexp.MustCompile(test.err),
+					},
+				},
+			})
+		})
+	}
+}
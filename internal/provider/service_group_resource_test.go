@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceGroupResource(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "onsched_service_group" "test" {
+  name = "Massage Therapy"
+}
+
+resource "onsched_service" "test" {
+  name             = "Swedish Massage"
+  duration         = 60
+  service_group_id = onsched_service_group.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("onsched_service_group.test", "id"),
+					resource.TestCheckResourceAttr("onsched_service_group.test", "sort_key", "0"),
+					resource.TestCheckNoResourceAttr("onsched_service_group.test", "description"),
+					resource.TestCheckResourceAttrPair("onsched_service.test", "service_group_id", "onsched_service_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_service_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "onsched_service_group" "test" {
+  name        = "Massage"
+  description = "Relaxation and therapeutic massages"
+  sort_key    = 2
+}
+
+resource "onsched_service" "test" {
+  name     = "Swedish Massage"
+  duration = 60
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_service_group.test", "name", "Massage"),
+					resource.TestCheckResourceAttr("onsched_service_group.test", "sort_key", "2"),
+					resource.TestCheckNoResourceAttr("onsched_service.test", "service_group_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
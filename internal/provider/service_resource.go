@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type serviceResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceResource{}
+	_ resource.ResourceWithConfigure   = &serviceResource{}
+	_ resource.ResourceWithImportState = &serviceResource{}
+)
+
+// NewServiceResource is a helper function to simplify the provider implementation.
+func NewServiceResource() resource.Resource {
+	return &serviceResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *serviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A bookable service of the OnSched company.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Service ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the location offering the service. Services without a location are offered company-wide.",
+				Optional:            true,
+			},
+			"service_group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `onsched_service_group` the service belongs to.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the service.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description shown to customers.",
+				Optional:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "Length of an appointment in minutes.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"duration_interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval in minutes between available start times. Defaults to the OnSched setting when unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"booking_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of bookings per time slot, `0` for no limit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"fees": schema.SingleNestedAttribute{
+				MarkdownDescription: "Pricing of the service.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"fee_amount": schema.Float64Attribute{
+						MarkdownDescription: "Price of the service.",
+						Optional:            true,
+						Computed:            true,
+						Default:             float64default.StaticFloat64(0),
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+					"fee_taxable": schema.BoolAttribute{
+						MarkdownDescription: "Whether tax applies to the fee.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"deposit_amount": schema.Float64Attribute{
+						MarkdownDescription: "Deposit collected when booking.",
+						Optional:            true,
+						Computed:            true,
+						Default:             float64default.StaticFloat64(0),
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	service, err := r.client.CreateService(ctx, plan.toService())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched service", err)
+		return
+	}
+
+	plan.refresh(service)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service, err := r.client.GetService(ctx, state.ID.ValueString())
+	if onsched.IsNotFound(err) || (err == nil && service.Deleted) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched service", err)
+		return
+	}
+
+	// Imported state holds only the ID, so track the fees if the API
+	// reports any.
+	if state.Name.IsNull() && service.Fees != (onsched.ServiceFees{}) {
+		state.Fees = &serviceFeesModel{}
+	}
+
+	state.refresh(service)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan serviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	service := plan.toService()
+
+	// Keep fees that are not configured, such as pricing set in the
+	// dashboard.
+	if plan.Fees == nil {
+		current, err := r.client.GetService(ctx, service.ID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error updating OnSched service", err)
+			return
+		}
+		service.Fees = current.Fees
+	}
+
+	service, err := r.client.UpdateService(ctx, service)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched service", err)
+		return
+	}
+
+	plan.refresh(service)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteService(ctx, state.ID.ValueString())
+	if err != nil && !onsched.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched service", err)
+		return
+	}
+}
+
+// ImportState imports an existing service by its ID.
+func (r *serviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type serviceResourceModel struct {
+	ID               types.String      `tfsdk:"id"`
+	LocationID       types.String      `tfsdk:"location_id"`
+	ServiceGroupID   types.String      `tfsdk:"service_group_id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	Duration         types.Int64       `tfsdk:"duration"`
+	DurationInterval types.Int64       `tfsdk:"duration_interval"`
+	BookingLimit     types.Int64       `tfsdk:"booking_limit"`
+	Fees             *serviceFeesModel `tfsdk:"fees"`
+	Timeouts         timeouts.Value    `tfsdk:"timeouts"`
+}
+
+type serviceFeesModel struct {
+	FeeAmount     types.Float64 `tfsdk:"fee_amount"`
+	FeeTaxable    types.Bool    `tfsdk:"fee_taxable"`
+	DepositAmount types.Float64 `tfsdk:"deposit_amount"`
+}
+
+// toService converts the model to the API representation.
+func (m *serviceResourceModel) toService() onsched.Service {
+	service := onsched.Service{
+		ID:               m.ID.ValueString(),
+		LocationID:       m.LocationID.ValueString(),
+		ServiceGroupID:   m.ServiceGroupID.ValueString(),
+		Name:             m.Name.ValueString(),
+		Description:      m.Description.ValueString(),
+		Duration:         int(m.Duration.ValueInt64()),
+		DurationInterval: int(m.DurationInterval.ValueInt64()),
+		BookingLimit:     int(m.BookingLimit.ValueInt64()),
+	}
+
+	if m.Fees != nil {
+		service.Fees = onsched.ServiceFees{
+			FeeAmount:     m.Fees.FeeAmount.ValueFloat64(),
+			FeeTaxable:    m.Fees.FeeTaxable.ValueBool(),
+			DepositAmount: m.Fees.DepositAmount.ValueFloat64(),
+		}
+	}
+
+	return service
+}
+
+// refresh updates the model from the API representation. Fees are only
+// tracked when configured, so that values the API fills in for them don't
+// conflict with a null configuration.
+func (m *serviceResourceModel) refresh(s onsched.Service) {
+	m.ID = types.StringValue(s.ID)
+	m.LocationID = optionalString(s.LocationID)
+	m.ServiceGroupID = optionalString(s.ServiceGroupID)
+	m.Name = types.StringValue(s.Name)
+	m.Description = optionalString(s.Description)
+	m.Duration = types.Int64Value(int64(s.Duration))
+	m.DurationInterval = types.Int64Value(int64(s.DurationInterval))
+	m.BookingLimit = types.Int64Value(int64(s.BookingLimit))
+
+	if m.Fees != nil {
+		m.Fees = &serviceFeesModel{
+			FeeAmount:     types.Float64Value(s.Fees.FeeAmount),
+			FeeTaxable:    types.BoolValue(s.Fees.FeeTaxable),
+			DepositAmount: types.Float64Value(s.Fees.DepositAmount),
+		}
+	}
+}
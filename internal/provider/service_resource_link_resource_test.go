@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"terraform-provider-onsched/internal/onschedtest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccServiceResourceLinkResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	const base = `
+resource "onsched_service" "massage" {
+  name     = "Swedish Massage"
+  duration = 60
+}
+
+resource "onsched_resource" "jane" {
+  name = "Jane Doe"
+}
+
+resource "onsched_resource" "john" {
+  name = "John Doe"
+}
+
+resource "onsched_resource" "mary" {
+  name = "Mary Major"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + base + `
+resource "onsched_service_resource" "jane" {
+  service_id  = onsched_service.massage.id
+  resource_id = onsched_resource.jane.id
+}
+
+resource "onsched_service_resource" "john" {
+  service_id  = onsched_service.massage.id
+  resource_id = onsched_resource.john.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("onsched_service_resource.jane", "resource_id", "onsched_resource.jane", "id"),
+					testAccCheckServiceResources(server, "onsched_service.massage", "onsched_resource.jane", "onsched_resource.john"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_service_resource.john",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the roster only touches the affected links
+			{
+				Config: providerConfig + base + `
+resource "onsched_service_resource" "john" {
+  service_id  = onsched_service.massage.id
+  resource_id = onsched_resource.john.id
+}
+
+resource "onsched_service_resource" "mary" {
+  service_id  = onsched_service.massage.id
+  resource_id = onsched_resource.mary.id
+}
+`,
+				Check: testAccCheckServiceResources(server, "onsched_service.massage", "onsched_resource.john", "onsched_resource.mary"),
+			},
+			// Links removed outside of Terraform are recreated
+			{
+				PreConfig: func() {
+					client := server.Client()
+					for _, id := range server.ServiceResources("service-1") {
+						if err := client.RemoveServiceResource(context.Background(), "service-1", id); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: providerConfig + base + `
+resource "onsched_service_resource" "john" {
+  service_id  = onsched_service.massage.id
+  resource_id = onsched_resource.john.id
+}
+
+resource "onsched_service_resource" "mary" {
+  service_id  = onsched_service.massage.id
+  resource_id = onsched_resource.mary.id
+}
+`,
+				Check: testAccCheckServiceResources(server, "onsched_service.massage", "onsched_resource.john", "onsched_resource.mary"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckServiceResources verifies that the resources linked to the
+// service stored by the server are exactly the given resources.
+func testAccCheckServiceResources(server *onschedtest.Server, service string, resources ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[service]
+		if !ok {
+			return fmt.Errorf("resource %s not found", service)
+		}
+
+		want := make([]string, len(resources))
+		for i, name := range resources {
+			r, ok := s.RootModule().Resources[name]
+			if !ok {
+				return fmt.Errorf("resource %s not found", name)
+			}
+			want[i] = r.Primary.ID
+		}
+		sort.Strings(want)
+
+		if got := server.ServiceResources(rs.Primary.ID); !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("service resources = %q, want %q", got, want)
+		}
+		return nil
+	}
+}
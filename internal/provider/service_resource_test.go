@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccServiceResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	const location = `
+resource "onsched_location" "test" {
+  name          = "Downtown"
+  timezone_name = "America/Toronto"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the fees assigned by the API aren't
+			// tracked
+			{
+				Config: providerConfig + location + `
+resource "onsched_service" "test" {
+  name     = "Swedish Massage"
+  duration = 60
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("onsched_service.test", "id"),
+					resource.TestCheckResourceAttr("onsched_service.test", "duration", "60"),
+					resource.TestCheckResourceAttr("onsched_service.test", "booking_limit", "0"),
+					resource.TestCheckNoResourceAttr("onsched_service.test", "location_id"),
+					resource.TestCheckNoResourceAttr("onsched_service.test", "fees.fee_taxable"),
+				),
+			},
+			// Updating leaves the fees alone when they aren't configured
+			{
+				Config: providerConfig + location + `
+resource "onsched_service" "test" {
+  name     = "Deep Tissue Massage"
+  duration = 60
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_service.test", "name", "Deep Tissue Massage"),
+					func(s *terraform.State) error {
+						service, _ := server.Service(s.RootModule().Resources["onsched_service.test"].Primary.ID)
+						if !service.Fees.FeeTaxable {
+							return fmt.Errorf("update reset the fees: %+v", service.Fees)
+						}
+						return nil
+					},
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + location + `
+resource "onsched_service" "test" {
+  name              = "Swedish Massage"
+  description       = "A relaxing full body massage."
+  location_id       = onsched_location.test.id
+  duration          = 90
+  duration_interval = 30
+  booking_limit     = 2
+
+  fees = {
+    fee_amount     = 120
+    deposit_amount = 20
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("onsched_service.test", "location_id", "onsched_location.test", "id"),
+					resource.TestCheckResourceAttr("onsched_service.test", "duration", "90"),
+					resource.TestCheckResourceAttr("onsched_service.test", "duration_interval", "30"),
+					resource.TestCheckResourceAttr("onsched_service.test", "fees.fee_amount", "120"),
+					resource.TestCheckResourceAttr("onsched_service.test", "fees.fee_taxable", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "onsched_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
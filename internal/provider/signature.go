@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const secretAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// fingerprintSaltSize is the number of random bytes salting a fingerprint.
+const fingerprintSaltSize = 16
+
+// secretFingerprint returns a non-reversible identifier of a webhook signature
+// secret that can be kept in state to detect rotation. It is null when no
+// secret is set.
+//
+// The fingerprint has the form "<salt>:<mac>", where mac is an HMAC-SHA256 of
+// the secret keyed with the random salt, so that fingerprints of the same
+// secret can't be compared across states or precomputed. The salt of prior is
+// reused when it is a fingerprint, which keeps the fingerprint stable while
+// the secret doesn't change.
+func secretFingerprint(secret string, prior types.String) types.String {
+	if secret == "" {
+		return types.StringNull()
+	}
+
+	salt, _, ok := splitFingerprint(prior)
+	if !ok {
+		salt = make([]byte, fingerprintSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			// crypto/rand doesn't fail on supported platforms.
+			panic(err)
+		}
+	}
+
+	return types.StringValue(hex.EncodeToString(salt) + ":" + hex.EncodeToString(fingerprintMAC(salt, secret)))
+}
+
+// fingerprintMatches reports whether fingerprint was computed from secret.
+// An empty secret matches a null fingerprint.
+func fingerprintMatches(fingerprint types.String, secret string) bool {
+	if secret == "" || fingerprint.IsNull() {
+		return secret == "" && fingerprint.IsNull()
+	}
+
+	salt, mac, ok := splitFingerprint(fingerprint)
+	return ok && hmac.Equal(mac, fingerprintMAC(salt, secret))
+}
+
+func fingerprintMAC(salt []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(secret))
+	return mac.Sum(nil)
+}
+
+// splitFingerprint decodes the salt and MAC of a fingerprint.
+func splitFingerprint(fingerprint types.String) (salt, mac []byte, ok bool) {
+	if fingerprint.IsNull() || fingerprint.IsUnknown() {
+		return nil, nil, false
+	}
+
+	s, m, found := strings.Cut(fingerprint.ValueString(), ":")
+	if !found {
+		return nil, nil, false
+	}
+	salt, err := hex.DecodeString(s)
+	if err != nil || len(salt) != fingerprintSaltSize {
+		return nil, nil, false
+	}
+	mac, err = hex.DecodeString(m)
+	if err != nil || len(mac) != sha256.Size {
+		return nil, nil, false
+	}
+	return salt, mac, true
+}
+
+// generateSecret returns a random alphanumeric secret of the given length.
+func generateSecret(length int) (string, error) {
+	max := big.NewInt(int64(len(secretAlphabet)))
+	secret := make([]byte, length)
+	for i := range secret {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		secret[i] = secretAlphabet[n.Int64()]
+	}
+	return string(secret), nil
+}
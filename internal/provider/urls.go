@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// absoluteURLValidator checks that a string is an absolute http or https URL.
+type absoluteURLValidator struct{}
+
+func (v absoluteURLValidator) Description(_ context.Context) string {
+	return "must be an absolute http or https URL"
+}
+
+func (v absoluteURLValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be an absolute `http` or `https` URL"
+}
+
+func (v absoluteURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkAbsoluteURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL", err.Error())
+	}
+}
+
+// checkAbsoluteURL reports why s isn't an absolute http or https URL.
+func checkAbsoluteURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %w", s, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q is not an absolute http or https URL.", s)
+	}
+	return nil
+}
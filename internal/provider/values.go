@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalString returns a null value for an empty string so that optional
+// attributes left out of the configuration stay null in state.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// setString assigns the value of v to dst unless v is null or unknown.
+func setString(dst *string, v types.String) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	*dst = v.ValueString()
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -6,10 +6,15 @@ import (
 	"terraform-provider-onsched/onsched"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,8 +24,9 @@ type webhookResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &webhookResource{}
-	_ resource.ResourceWithConfigure = &webhookResource{}
+	_ resource.Resource                = &webhookResource{}
+	_ resource.ResourceWithConfigure   = &webhookResource{}
+	_ resource.ResourceWithImportState = &webhookResource{}
 )
 
 // NewWebhookResource is a helper function to simplify the provider implementation.
@@ -54,41 +60,47 @@ func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 // Schema defines the schema for the resource.
-func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *webhookResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Webhooks for OnSched",
+		MarkdownDescription: "Webhooks for OnSched. Existing webhooks can be imported by company ID with `terraform import` or an `import` block.",
 
 		Attributes: map[string]schema.Attribute{
-			"booking_webhook_url": schema.StringAttribute{
-				MarkdownDescription: "Webhook called when a booking event occurs.",
-				Default:             stringdefault.StaticString("SOFT_DELETED"),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the company the webhooks belong to.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"booking_webhook_url": schema.StringAttribute{
+				MarkdownDescription: "Webhook called when a booking event occurs. Left unset, the webhook is cleared.",
 				Optional:            true,
 			},
 			"customer_webhook_url": schema.StringAttribute{
-				MarkdownDescription: "Webhook called when a customer event occurs.",
-				Default:             stringdefault.StaticString("SOFT_DELETED"),
-				Computed:            true,
+				MarkdownDescription: "Webhook called when a customer event occurs. Left unset, the webhook is cleared.",
 				Optional:            true,
 			},
 			"resource_webhook_url": schema.StringAttribute{
-				MarkdownDescription: "Webhook called when a resource event occurs.",
-				Default:             stringdefault.StaticString("SOFT_DELETED"),
-				Computed:            true,
+				MarkdownDescription: "Webhook called when a resource event occurs. Left unset, the webhook is cleared.",
 				Optional:            true,
 			},
 			"reminder_webhook_url": schema.StringAttribute{
-				MarkdownDescription: "Webhook called when a reminder event occurs.",
-				Default:             stringdefault.StaticString("SOFT_DELETED"),
-				Computed:            true,
+				MarkdownDescription: "Webhook called when a reminder event occurs. Left unset, the webhook is cleared.",
 				Optional:            true,
 			},
 			"webhook_signature_hash": schema.StringAttribute{
-				MarkdownDescription: "Webhook signature hash",
-				Default:             stringdefault.StaticString(""),
-				Computed:            true,
+				MarkdownDescription: "Webhook signature hash. Left unset, the current secret is kept and not stored in state.",
 				Optional:            true,
+				Sensitive:           true,
+				DeprecationMessage: "Configured secrets are stored in state. Use the onsched_webhook_signature resource instead; " +
+					"this argument will be removed in the next major version.",
+			},
+			"webhook_signature_hash_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Salted fingerprint of the current webhook signature hash, which changes when the secret is rotated. " +
+					"The secret itself is managed with `onsched_webhook_signature`. " +
+					"It is unknown until applied, because the secret may be rotated in the same apply.",
+				Computed: true,
 			},
 			"disable_email_and_sms_notifications": schema.BoolAttribute{
 				MarkdownDescription: "This will disable all email and sms notifications, webhooks will still be triggered",
@@ -96,10 +108,28 @@ func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:            true,
 				Computed:            true,
 			},
+			"delete_behavior": schema.StringAttribute{
+				MarkdownDescription: "What happens to the webhooks when the resource is destroyed. " +
+					"`clear` removes the webhook URLs from the company, `retain` leaves them as they are and only removes the resource from the Terraform state. Defaults to `clear`.",
+				Default:  stringdefault.StaticString(webhookDeleteClear),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(webhookDeleteClear, webhookDeleteRetain),
+				},
+			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -113,46 +143,25 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	company, err := r.client.GetCompany()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched webhook",
-			err.Error(),
-		)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	company.BookingWebhookURL = plan.BookingWebhookURL.ValueString()
-	company.CustomerWebhookURL = plan.CustomerWebhookURL.ValueString()
-	company.ReminderWebhookURL = plan.ReminderWebhookURL.ValueString()
-	company.ResourceWebhookURL = plan.ResourceWebhookURL.ValueString()
-	company.WebhookSignatureHash = plan.WebhookSignatureHash.ValueString()
-	company.DisableEmailAndSmsNotifications = plan.DisableEmailAndSmsNotifications.ValueBool()
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	_, err = r.client.UpdateCompany(company)
+	company, err := r.client.MutateCompany(ctx, nil, func(company *onsched.Company) error {
+		plan.apply(company)
+		return nil
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched webhook",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error updating OnSched webhook", err)
 		return
 	}
 
-	company, err = r.client.GetCompany()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched webhook",
-			err.Error(),
-		)
-		return
-	}
-
-	plan.BookingWebhookURL = types.StringValue(company.BookingWebhookURL)
-	plan.CustomerWebhookURL = types.StringValue(company.CustomerWebhookURL)
-	plan.ReminderWebhookURL = types.StringValue(company.ReminderWebhookURL)
-	plan.ResourceWebhookURL = types.StringValue(company.ResourceWebhookURL)
-	plan.WebhookSignatureHash = types.StringValue(company.WebhookSignatureHash)
-	plan.DisableEmailAndSmsNotifications = types.BoolValue(company.DisableEmailAndSmsNotifications)
+	plan.refresh(company)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -171,21 +180,31 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	c, err := r.client.GetCompany()
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	c, err := r.client.GetCompany(ctx)
+	if onsched.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading OnSched webhook",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error reading OnSched webhook", err)
 		return
 	}
 
-	state.BookingWebhookURL = types.StringValue(c.BookingWebhookURL)
-	state.CustomerWebhookURL = types.StringValue(c.CustomerWebhookURL)
-	state.ReminderWebhookURL = types.StringValue(c.ReminderWebhookURL)
-	state.ResourceWebhookURL = types.StringValue(c.ResourceWebhookURL)
-	state.WebhookSignatureHash = types.StringValue(c.WebhookSignatureHash)
-	state.DisableEmailAndSmsNotifications = types.BoolValue(c.DisableEmailAndSmsNotifications)
+	state.refresh(c)
+
+	// Imported resources have no delete behavior yet.
+	if state.DeleteBehavior.IsNull() {
+		state.DeleteBehavior = types.StringValue(webhookDeleteClear)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -206,46 +225,34 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	company, err := r.client.GetCompany()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched webhook",
-			err.Error(),
-		)
+	var state webhookResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	company.BookingWebhookURL = plan.BookingWebhookURL.ValueString()
-	company.CustomerWebhookURL = plan.CustomerWebhookURL.ValueString()
-	company.ReminderWebhookURL = plan.ReminderWebhookURL.ValueString()
-	company.ResourceWebhookURL = plan.ResourceWebhookURL.ValueString()
-	company.WebhookSignatureHash = plan.WebhookSignatureHash.ValueString()
-	company.DisableEmailAndSmsNotifications = plan.DisableEmailAndSmsNotifications.ValueBool()
-
-	_, err = r.client.UpdateCompany(company)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched webhook",
-			err.Error(),
-		)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	company, err = r.client.GetCompany()
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	company, err := r.client.MutateCompany(ctx, companyConflicts(state.apply), func(company *onsched.Company) error {
+		plan.apply(company)
+		return nil
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched webhook",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error updating OnSched webhook", err)
 		return
 	}
 
-	plan.BookingWebhookURL = types.StringValue(company.BookingWebhookURL)
-	plan.CustomerWebhookURL = types.StringValue(company.CustomerWebhookURL)
-	plan.ReminderWebhookURL = types.StringValue(company.ReminderWebhookURL)
-	plan.ResourceWebhookURL = types.StringValue(company.ResourceWebhookURL)
-	plan.WebhookSignatureHash = types.StringValue(company.WebhookSignatureHash)
-	plan.DisableEmailAndSmsNotifications = types.BoolValue(company.DisableEmailAndSmsNotifications)
+	// Keep the salt of the fingerprint, which is unknown in the plan.
+	plan.WebhookSignatureFingerprint = state.WebhookSignatureFingerprint
+	plan.refresh(company)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -255,7 +262,7 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 }
 
-// Delete deletes the resource and removes the Terraform state on success.
+// Delete clears the webhook URLs unless delete_behavior is retain.
 func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
 	var state webhookResourceModel
@@ -265,59 +272,78 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	company, err := r.client.GetCompany()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting OnSched webhook",
-			err.Error(),
-		)
+	if state.DeleteBehavior.ValueString() == webhookDeleteRetain {
 		return
 	}
 
-	company.BookingWebhookURL = "SOFT_DELETED"
-	company.CustomerWebhookURL = "SOFT_DELETED"
-	company.ReminderWebhookURL = "SOFT_DELETED"
-	company.ResourceWebhookURL = "SOFT_DELETED"
-
-	_, err = r.client.UpdateCompany(company)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting OnSched webhook",
-			err.Error(),
-		)
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	company, err = r.client.GetCompany()
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.MutateCompany(ctx, companyConflicts(state.apply), func(company *onsched.Company) error {
+		company.BookingWebhookURL = ""
+		company.CustomerWebhookURL = ""
+		company.ReminderWebhookURL = ""
+		company.ResourceWebhookURL = ""
+		return nil
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating OnSched webhook",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error deleting OnSched webhook", err)
 		return
 	}
+}
 
-	state.BookingWebhookURL = types.StringValue(company.BookingWebhookURL)
-	state.CustomerWebhookURL = types.StringValue(company.CustomerWebhookURL)
-	state.ReminderWebhookURL = types.StringValue(company.ReminderWebhookURL)
-	state.ResourceWebhookURL = types.StringValue(company.ResourceWebhookURL)
-	state.WebhookSignatureHash = types.StringValue(company.WebhookSignatureHash)
-	state.DisableEmailAndSmsNotifications = types.BoolValue(company.DisableEmailAndSmsNotifications)
-	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-
-	diags = resp.State.Set(ctx, state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+// ImportState imports the webhooks of the company with the given ID.
+func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompanyState(ctx, r.client, req, resp)
 }
 
+const (
+	webhookDeleteClear  = "clear"
+	webhookDeleteRetain = "retain"
+)
+
 type webhookResourceModel struct {
-	BookingWebhookURL               types.String `tfsdk:"booking_webhook_url"`
-	CustomerWebhookURL              types.String `tfsdk:"customer_webhook_url"`
-	ReminderWebhookURL              types.String `tfsdk:"reminder_webhook_url"`
-	ResourceWebhookURL              types.String `tfsdk:"resource_webhook_url"`
-	WebhookSignatureHash            types.String `tfsdk:"webhook_signature_hash"`
-	DisableEmailAndSmsNotifications types.Bool   `tfsdk:"disable_email_and_sms_notifications"`
-	LastUpdated                     types.String `tfsdk:"last_updated"`
+	ID                              types.String   `tfsdk:"id"`
+	BookingWebhookURL               types.String   `tfsdk:"booking_webhook_url"`
+	CustomerWebhookURL              types.String   `tfsdk:"customer_webhook_url"`
+	ReminderWebhookURL              types.String   `tfsdk:"reminder_webhook_url"`
+	ResourceWebhookURL              types.String   `tfsdk:"resource_webhook_url"`
+	WebhookSignatureHash            types.String   `tfsdk:"webhook_signature_hash"`
+	WebhookSignatureFingerprint     types.String   `tfsdk:"webhook_signature_hash_fingerprint"`
+	DisableEmailAndSmsNotifications types.Bool     `tfsdk:"disable_email_and_sms_notifications"`
+	DeleteBehavior                  types.String   `tfsdk:"delete_behavior"`
+	LastUpdated                     types.String   `tfsdk:"last_updated"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// apply copies the planned values onto company. Null URLs clear the webhook,
+// a null signature hash leaves the current secret in place.
+func (m *webhookResourceModel) apply(company *onsched.Company) {
+	company.BookingWebhookURL = m.BookingWebhookURL.ValueString()
+	company.CustomerWebhookURL = m.CustomerWebhookURL.ValueString()
+	company.ReminderWebhookURL = m.ReminderWebhookURL.ValueString()
+	company.ResourceWebhookURL = m.ResourceWebhookURL.ValueString()
+	setString(&company.WebhookSignatureHash, m.WebhookSignatureHash)
+	company.DisableEmailAndSmsNotifications = m.DisableEmailAndSmsNotifications.ValueBool()
+}
+
+// refresh updates the model from the API representation.
+func (m *webhookResourceModel) refresh(c onsched.Company) {
+	m.ID = types.StringValue(c.ID)
+	m.BookingWebhookURL = optionalString(c.BookingWebhookURL)
+	m.CustomerWebhookURL = optionalString(c.CustomerWebhookURL)
+	m.ReminderWebhookURL = optionalString(c.ReminderWebhookURL)
+	m.ResourceWebhookURL = optionalString(c.ResourceWebhookURL)
+	// The secret is only kept in state when it is managed by this resource.
+	if !m.WebhookSignatureHash.IsNull() {
+		m.WebhookSignatureHash = optionalString(c.WebhookSignatureHash)
+	}
+	m.WebhookSignatureFingerprint = secretFingerprint(c.WebhookSignatureHash, m.WebhookSignatureFingerprint)
+	m.DisableEmailAndSmsNotifications = types.BoolValue(c.DisableEmailAndSmsNotifications)
 }
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			company := server.Company()
+			if company.BookingWebhookURL != "" || company.CustomerWebhookURL != "" {
+				return fmt.Errorf("webhook URLs were not cleared: %+v", company)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "onsched_webhook" "test" {
+  booking_webhook_url  = "https://example.com/bookings"
+  customer_webhook_url = "https://example.com/customers"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_webhook.test", "id", "test-company"),
+					resource.TestCheckResourceAttr("onsched_webhook.test", "booking_webhook_url", "https://example.com/bookings"),
+					resource.TestCheckResourceAttr("onsched_webhook.test", "customer_webhook_url", "https://example.com/customers"),
+					resource.TestCheckNoResourceAttr("onsched_webhook.test", "reminder_webhook_url"),
+					resource.TestCheckResourceAttr("onsched_webhook.test", "delete_behavior", "clear"),
+					resource.TestCheckResourceAttrSet("onsched_webhook.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "onsched_webhook.test",
+				ImportState:             true,
+				ImportStateId:           "test-company",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "onsched_webhook" "test" {
+  booking_webhook_url  = "https://example.com/v2/bookings"
+  reminder_webhook_url = "https://example.com/reminders"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_webhook.test", "booking_webhook_url", "https://example.com/v2/bookings"),
+					resource.TestCheckNoResourceAttr("onsched_webhook.test", "customer_webhook_url"),
+					resource.TestCheckResourceAttr("onsched_webhook.test", "reminder_webhook_url", "https://example.com/reminders"),
+					func(*terraform.State) error {
+						if url := server.Company().CustomerWebhookURL; url != "" {
+							return fmt.Errorf("customer webhook URL was not cleared: %q", url)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWebhookResource_importWrongCompany(t *testing.T) {
+	_, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "onsched_webhook" "test" {
+  booking_webhook_url = "https://example.com/bookings"
+}
+`,
+			},
+			{
+				ResourceName:  "onsched_webhook.test",
+				ImportState:   true,
+				ImportStateId: "another-company",
+				ExpectError:   regexp.MustCompile(`belong to company "test-company"`),
+			},
+		},
+	})
+}
+
+func TestAccWebhookResource_rotateSecret(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	// config returns the webhook, updated after the secret is rotated.
+	config := func(rotation, url string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "onsched_webhook_signature" "test" {
+  keepers = {
+    rotation = %q
+  }
+}
+
+resource "onsched_webhook" "test" {
+  booking_webhook_url = %q
+
+  depends_on = [onsched_webhook_signature.test]
+}
+`, rotation, url)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("1", "https://example.com/bookings"),
+				Check:  testAccCheckFingerprint(server, "onsched_webhook.test", "webhook_signature_hash_fingerprint"),
+			},
+			// The fingerprint follows a secret rotated in the same apply.
+			{
+				Config: config("2", "https://example.com/v2/bookings"),
+				Check:  testAccCheckFingerprint(server, "onsched_webhook.test", "webhook_signature_hash_fingerprint"),
+			},
+		},
+	})
+}
+
+func TestAccWebhookResource_signatureHash(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The deprecated argument still sets the secret.
+			{
+				Config: providerConfig + `
+resource "onsched_webhook" "test" {
+  booking_webhook_url    = "https://example.com/bookings"
+  webhook_signature_hash = "legacy-secret"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_webhook.test", "webhook_signature_hash", "legacy-secret"),
+					testAccCheckFingerprint(server, "onsched_webhook.test", "webhook_signature_hash_fingerprint"),
+					func(*terraform.State) error {
+						if hash := server.Company().WebhookSignatureHash; hash != "legacy-secret" {
+							return fmt.Errorf("secret was not set: %q", hash)
+						}
+						return nil
+					},
+				),
+			},
+			// Removing it keeps the secret without storing it in state.
+			{
+				Config: providerConfig + `
+resource "onsched_webhook" "test" {
+  booking_webhook_url = "https://example.com/bookings"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("onsched_webhook.test", "webhook_signature_hash"),
+					func(*terraform.State) error {
+						if hash := server.Company().WebhookSignatureHash; hash != "legacy-secret" {
+							return fmt.Errorf("secret was changed: %q", hash)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type webhookSignatureResource struct {
+	client *onsched.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &webhookSignatureResource{}
+	_ resource.ResourceWithConfigure = &webhookSignatureResource{}
+)
+
+// NewWebhookSignatureResource is a helper function to simplify the provider implementation.
+func NewWebhookSignatureResource() resource.Resource {
+	return &webhookSignatureResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webhookSignatureResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *webhookSignatureResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_signature"
+}
+
+// Schema defines the schema for the resource.
+func (r *webhookSignatureResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the webhook signature secret, the `webhookSignatureHash` of the company, to a random or given value. " +
+			"Only the fingerprint of a generated secret is stored in state, unless `export_secret` is set to pass the secret on to webhook receivers. " +
+			"`onsched_webhook` and `onsched_company` only track the fingerprint of the secret. " +
+			"Change `keepers` to rotate a generated secret. " +
+			"If the secret is changed outside of Terraform, a new one is generated, or the given one set again, on the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the company the secret belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "Length of the generated secret. Defaults to `32`. Conflicts with `secret`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(32),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(16, 128),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The webhook signature secret. Generated when unset, in which case it is only exported when `export_secret` is set. " +
+					"Changing a given secret replaces the resource. As with any argument, a given secret is stored in state.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					exportSecretModifier{},
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.ConfigValue.IsNull()
+						},
+						"Changing a given secret replaces the resource.",
+						"Changing a given secret replaces the resource.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(16),
+					stringvalidator.ConflictsWith(path.MatchRoot("length")),
+				},
+			},
+			"export_secret": schema.BoolAttribute{
+				MarkdownDescription: "Export the generated secret as the sensitive `secret` attribute, storing it in state. Defaults to `false`. " +
+					"Changing it doesn't rotate the secret.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that, when changed, generate a new secret.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Salted fingerprint of the secret.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create sets the given or a newly generated secret on the company.
+func (r *webhookSignatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan webhookSignatureResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	secret := plan.Secret.ValueString()
+	generated := plan.Secret.IsUnknown()
+	if generated {
+		var err error
+		secret, err = generateSecret(int(plan.Length.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Error generating OnSched webhook signature", err.Error())
+			return
+		}
+	}
+
+	company, err := r.client.MutateCompany(ctx, nil, func(company *onsched.Company) error {
+		company.WebhookSignatureHash = secret
+		return nil
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error setting OnSched webhook signature", err)
+		return
+	}
+
+	plan.ID = types.StringValue(company.ID)
+	plan.Secret = types.StringValue(secret)
+	if generated && !plan.ExportSecret.ValueBool() {
+		plan.Secret = types.StringNull()
+	}
+	plan.Fingerprint = secretFingerprint(secret, types.StringNull())
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read removes the resource from state when the secret of the company no
+// longer matches, so that it is set again.
+func (r *webhookSignatureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookSignatureResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	company, err := r.client.GetCompany(ctx)
+	if onsched.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched webhook signature", err)
+		return
+	}
+
+	if !fingerprintMatches(state.Fingerprint, company.WebhookSignatureHash) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// Update only changes whether the secret is exported, as every other
+// argument requires replacement.
+func (r *webhookSignatureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state webhookSignatureResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The secret is only unknown when export_secret changed.
+	if plan.Secret.IsUnknown() {
+		plan.Secret = types.StringNull()
+		if plan.ExportSecret.ValueBool() {
+			company, err := r.client.GetCompany(ctx)
+			if err != nil {
+				addClientError(&resp.Diagnostics, "Error exporting OnSched webhook signature", err)
+				return
+			}
+			if !fingerprintMatches(state.Fingerprint, company.WebhookSignatureHash) {
+				resp.Diagnostics.AddError(
+					"Error exporting OnSched webhook signature",
+					"The webhook signature secret of the company was changed since the last refresh. Refresh the state and apply again.",
+				)
+				return
+			}
+			plan.Secret = types.StringValue(company.WebhookSignatureHash)
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete clears the secret, unless it was already replaced by another one.
+func (r *webhookSignatureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state webhookSignatureResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.MutateCompany(ctx, nil, func(company *onsched.Company) error {
+		if fingerprintMatches(state.Fingerprint, company.WebhookSignatureHash) {
+			company.WebhookSignatureHash = ""
+		}
+		return nil
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error clearing OnSched webhook signature", err)
+		return
+	}
+}
+
+type webhookSignatureResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Length       types.Int64    `tfsdk:"length"`
+	Keepers      types.Map      `tfsdk:"keepers"`
+	Secret       types.String   `tfsdk:"secret"`
+	ExportSecret types.Bool     `tfsdk:"export_secret"`
+	Fingerprint  types.String   `tfsdk:"fingerprint"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// exportSecretModifier plans a generated secret as unknown when export_secret
+// changes, so that Update can export or drop it.
+type exportSecretModifier struct{}
+
+func (m exportSecretModifier) Description(_ context.Context) string {
+	return "Updates a generated secret when export_secret changes."
+}
+
+func (m exportSecretModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m exportSecretModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to do on create or destroy, or for a given secret.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var planned, prior types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("export_secret"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("export_secret"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planned.Equal(prior) {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
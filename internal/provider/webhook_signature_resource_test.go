@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-onsched/internal/onschedtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWebhookSignatureResource(t *testing.T) {
+	server, providerConfig := testAccServer(t)
+
+	// config returns the configuration with the given extra arguments of
+	// the signature.
+	config := func(arguments string) string {
+		return providerConfig + `
+resource "onsched_webhook_signature" "test" {
+  keepers = {
+    rotation = "1"
+  }
+` + arguments + `
+}
+
+resource "onsched_webhook" "test" {
+  booking_webhook_url = "https://example.com/bookings"
+
+  depends_on = [onsched_webhook_signature.test]
+}
+`
+	}
+
+	var secret string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if hash := server.Company().WebhookSignatureHash; hash != "" {
+				return fmt.Errorf("webhook signature hash was not cleared: %q", hash)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("export_secret = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_webhook_signature.test", "id", "test-company"),
+					resource.TestCheckResourceAttr("onsched_webhook_signature.test", "length", "32"),
+					func(*terraform.State) error {
+						secret = server.Company().WebhookSignatureHash
+						if len(secret) != 32 {
+							return fmt.Errorf("unexpected secret %q", secret)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttrWith("onsched_webhook_signature.test", "secret", func(value string) error {
+						if value != secret {
+							return fmt.Errorf("secret = %q, want %q", value, secret)
+						}
+						return nil
+					}),
+					testAccCheckFingerprint(server, "onsched_webhook_signature.test", "fingerprint"),
+					testAccCheckFingerprint(server, "onsched_webhook.test", "webhook_signature_hash_fingerprint"),
+				),
+			},
+			// Fingerprints are salted independently and stay stable on refresh.
+			{
+				Config: config("export_secret = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						signature := s.RootModule().Resources["onsched_webhook_signature.test"].Primary.Attributes["fingerprint"]
+						webhook := s.RootModule().Resources["onsched_webhook.test"].Primary.Attributes["webhook_signature_hash_fingerprint"]
+						if signature == webhook {
+							return fmt.Errorf("fingerprints share the same salt: %q", signature)
+						}
+						return nil
+					},
+					func(*terraform.State) error {
+						if hash := server.Company().WebhookSignatureHash; hash != secret {
+							return fmt.Errorf("secret was rotated without a change: %q", hash)
+						}
+						return nil
+					},
+				),
+			},
+			// No longer exporting the secret doesn't rotate it.
+			{
+				Config: config(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("onsched_webhook_signature.test", "secret"),
+					resource.TestCheckResourceAttr("onsched_webhook_signature.test", "export_secret", "false"),
+					func(*terraform.State) error {
+						if hash := server.Company().WebhookSignatureHash; hash != secret {
+							return fmt.Errorf("secret was rotated: %q", hash)
+						}
+						return nil
+					},
+				),
+			},
+			// Exporting the secret again exports the current one.
+			{
+				Config: config("export_secret = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("onsched_webhook_signature.test", "secret", func(value string) error {
+						if value != secret || server.Company().WebhookSignatureHash != secret {
+							return fmt.Errorf("secret = %q, want %q", value, secret)
+						}
+						return nil
+					}),
+				),
+			},
+			// A secret changed outside of Terraform is replaced.
+			{
+				PreConfig: func() {
+					company := server.Company()
+					company.WebhookSignatureHash = "changed-elsewhere"
+					server.SetCompany(company)
+				},
+				Config: config(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("onsched_webhook_signature.test", "secret"),
+					func(*terraform.State) error {
+						if hash := server.Company().WebhookSignatureHash; hash == "changed-elsewhere" || hash == secret {
+							return fmt.Errorf("secret was not regenerated: %q", hash)
+						}
+						return nil
+					},
+					testAccCheckFingerprint(server, "onsched_webhook_signature.test", "fingerprint"),
+				),
+			},
+			// A given secret replaces the generated one.
+			{
+				Config: providerConfig + `
+resource "onsched_webhook_signature" "test" {
+  secret = "given-secret-0123456789"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("onsched_webhook_signature.test", "secret", "given-secret-0123456789"),
+					testAccCheckFingerprint(server, "onsched_webhook_signature.test", "fingerprint"),
+					func(*terraform.State) error {
+						if hash := server.Company().WebhookSignatureHash; hash != "given-secret-0123456789" {
+							return fmt.Errorf("secret was not set: %q", hash)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckFingerprint checks that the named attribute is a fingerprint of
+// the current webhook signature secret of the company.
+func testAccCheckFingerprint(server *onschedtest.Server, name, key string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(name, key, func(value string) error {
+		if !fingerprintMatches(types.StringValue(value), server.Company().WebhookSignatureHash) {
+			return fmt.Errorf("%s is not a fingerprint of the current secret: %q", key, value)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webhookKind describes one of the webhook URLs stored on the company.
+type webhookKind struct {
+	// name is the event kind, e.g. "booking".
+	name string
+	// field returns the company field holding the URL.
+	field func(*onsched.Company) *string
+}
+
+type webhookURLResource struct {
+	client *onsched.Client
+	kind   webhookKind
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webhookURLResource{}
+	_ resource.ResourceWithConfigure   = &webhookURLResource{}
+	_ resource.ResourceWithImportState = &webhookURLResource{}
+)
+
+// NewBookingWebhookResource returns the onsched_booking_webhook resource.
+func NewBookingWebhookResource() resource.Resource {
+	return &webhookURLResource{kind: webhookKind{
+		name:  "booking",
+		field: func(c *onsched.Company) *string { return &c.BookingWebhookURL },
+	}}
+}
+
+// NewCustomerWebhookResource returns the onsched_customer_webhook resource.
+func NewCustomerWebhookResource() resource.Resource {
+	return &webhookURLResource{kind: webhookKind{
+		name:  "customer",
+		field: func(c *onsched.Company) *string { return &c.CustomerWebhookURL },
+	}}
+}
+
+// NewReminderWebhookResource returns the onsched_reminder_webhook resource.
+func NewReminderWebhookResource() resource.Resource {
+	return &webhookURLResource{kind: webhookKind{
+		name:  "reminder",
+		field: func(c *onsched.Company) *string { return &c.ReminderWebhookURL },
+	}}
+}
+
+// NewResourceWebhookResource returns the onsched_resource_webhook resource.
+func NewResourceWebhookResource() resource.Resource {
+	return &webhookURLResource{kind: webhookKind{
+		name:  "resource",
+		field: func(c *onsched.Company) *string { return &c.ResourceWebhookURL },
+	}}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webhookURLResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*onsched.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *onsched.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *webhookURLResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.kind.name + "_webhook"
+}
+
+// Schema defines the schema for the resource.
+func (r *webhookURLResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("The %s webhook of the OnSched company. ", r.kind.name) +
+			"Only manages the " + r.kind.name + " webhook URL, so it can be owned separately from the other webhooks. " +
+			"Do not combine with `onsched_webhook` or the webhook attributes of `onsched_company`, which manage the same URL.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the company the webhook belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("URL called when a %s event occurs.", r.kind.name),
+				Required:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webhookURLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan webhookURLResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	company, err := r.setURL(ctx, nil, plan.URL.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating OnSched "+r.kind.name+" webhook", err)
+		return
+	}
+
+	plan.ID = types.StringValue(company.ID)
+	plan.URL = types.StringValue(*r.kind.field(&company))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webhookURLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookURLResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	company, err := r.client.GetCompany(ctx)
+	if onsched.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading OnSched "+r.kind.name+" webhook", err)
+		return
+	}
+
+	// A cleared URL means the webhook no longer exists.
+	url := *r.kind.field(&company)
+	if url == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(company.ID)
+	state.URL = types.StringValue(url)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webhookURLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan webhookURLResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state webhookURLResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	company, err := r.setURL(ctx, &state, plan.URL.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating OnSched "+r.kind.name+" webhook", err)
+		return
+	}
+
+	plan.ID = types.StringValue(company.ID)
+	plan.URL = types.StringValue(*r.kind.field(&company))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete clears the webhook URL.
+func (r *webhookURLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state webhookURLResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.setURL(ctx, &state, "")
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting OnSched "+r.kind.name+" webhook", err)
+		return
+	}
+}
+
+// ImportState imports the webhook of the company with the given ID.
+func (r *webhookURLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompanyState(ctx, r.client, req, resp)
+}
+
+// setURL updates only the URL of this webhook kind on the company. When prior
+// is not nil, the URL must still match the prior state.
+func (r *webhookURLResource) setURL(ctx context.Context, prior *webhookURLResourceModel, url string) (onsched.Company, error) {
+	var check func(onsched.Company) []string
+	if prior != nil {
+		check = companyConflicts(func(company *onsched.Company) {
+			*r.kind.field(company) = prior.URL.ValueString()
+		})
+	}
+
+	return r.client.MutateCompany(ctx, check, func(company *onsched.Company) error {
+		*r.kind.field(company) = url
+		return nil
+	})
+}
+
+type webhookURLResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	URL      types.String   `tfsdk:"url"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
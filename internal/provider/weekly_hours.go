@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-onsched/onsched"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var clockPattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// weekdays lists the attribute names of a weekly schedule in order.
+var weekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+var weekdayNames = map[string]string{
+	"mon": "Monday",
+	"tue": "Tuesday",
+	"wed": "Wednesday",
+	"thu": "Thursday",
+	"fri": "Friday",
+	"sat": "Saturday",
+	"sun": "Sunday",
+}
+
+type weeklyHoursModel struct {
+	Mon *dayHoursModel `tfsdk:"mon"`
+	Tue *dayHoursModel `tfsdk:"tue"`
+	Wed *dayHoursModel `tfsdk:"wed"`
+	Thu *dayHoursModel `tfsdk:"thu"`
+	Fri *dayHoursModel `tfsdk:"fri"`
+	Sat *dayHoursModel `tfsdk:"sat"`
+	Sun *dayHoursModel `tfsdk:"sun"`
+}
+
+type dayHoursModel struct {
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+// weeklyHoursAttributes returns the per-weekday attributes of a weekly
+// schedule. A weekday left unset is closed.
+func weeklyHoursAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(weekdays))
+	for _, day := range weekdays {
+		attributes[day] = schema.SingleNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("Hours on %s. Leave unset when closed.", weekdayNames[day]),
+			Optional:            true,
+			Validators: []validator.Object{
+				timeRangeValidator{},
+			},
+			Attributes: dayHoursAttributes("Opening time", "Closing time"),
+		}
+	}
+	return attributes
+}
+
+// dayHoursAttributes returns the start_time and end_time attributes of a
+// time range, described by the given labels.
+func dayHoursAttributes(start, end string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"start_time": schema.StringAttribute{
+			MarkdownDescription: start + " in 24-hour `HH:MM` format.",
+			Required:            true,
+			Validators:          clockValidators(),
+		},
+		"end_time": schema.StringAttribute{
+			MarkdownDescription: end + " in 24-hour `HH:MM` format.",
+			Required:            true,
+			Validators:          clockValidators(),
+		},
+	}
+}
+
+func clockValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(clockPattern, "must be a 24-hour time in HH:MM format"),
+	}
+}
+
+// timeRangeValidator checks that the start_time of a day is before its
+// end_time.
+type timeRangeValidator struct{}
+
+func (v timeRangeValidator) Description(_ context.Context) string {
+	return "start_time must be before end_time"
+}
+
+func (v timeRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return "`start_time` must be before `end_time`"
+}
+
+func (v timeRangeValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var day dayHoursModel
+	diags := req.ConfigValue.As(ctx, &day, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Malformed or unknown times are reported by the attribute validators.
+	start, err := parseClock(day.StartTime.ValueString())
+	if err != nil {
+		return
+	}
+	end, err := parseClock(day.EndTime.ValueString())
+	if err != nil {
+		return
+	}
+
+	if start >= end {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Range",
+			fmt.Sprintf("start_time %s must be before end_time %s.", day.StartTime.ValueString(), day.EndTime.ValueString()),
+		)
+	}
+}
+
+func (m *weeklyHoursModel) days() []**dayHoursModel {
+	return []**dayHoursModel{&m.Mon, &m.Tue, &m.Wed, &m.Thu, &m.Fri, &m.Sat, &m.Sun}
+}
+
+// toBusinessHours converts the model to the API representation.
+func (m *weeklyHoursModel) toBusinessHours() (onsched.BusinessHours, error) {
+	var hours onsched.BusinessHours
+	if m == nil {
+		return hours, nil
+	}
+
+	apiDays := hours.Days()
+	for i, day := range m.days() {
+		if *day == nil {
+			continue
+		}
+		interval, err := (*day).toDayHours()
+		if err != nil {
+			return hours, fmt.Errorf("%s.%w", weekdays[i], err)
+		}
+		*apiDays[i] = interval
+	}
+	return hours, nil
+}
+
+// newWeeklyHoursModel converts API hours to the model, returning nil when
+// every day is closed.
+func newWeeklyHoursModel(hours onsched.BusinessHours) *weeklyHoursModel {
+	m := &weeklyHoursModel{}
+	open := false
+	for i, day := range hours.Days() {
+		if day.Closed() {
+			continue
+		}
+		open = true
+		*m.days()[i] = newDayHoursModel(*day)
+	}
+	if !open {
+		return nil
+	}
+	return m
+}
+
+// toDayHours converts the model to the API representation.
+func (m *dayHoursModel) toDayHours() (onsched.DayHours, error) {
+	start, err := parseClock(m.StartTime.ValueString())
+	if err != nil {
+		return onsched.DayHours{}, fmt.Errorf("start_time: %w", err)
+	}
+	end, err := parseClock(m.EndTime.ValueString())
+	if err != nil {
+		return onsched.DayHours{}, fmt.Errorf("end_time: %w", err)
+	}
+	hours := onsched.DayHours{StartTime: start, EndTime: end}
+	if err := hours.Validate(); err != nil {
+		return hours, fmt.Errorf("start_time: %w", err)
+	}
+	return hours, nil
+}
+
+func newDayHoursModel(hours onsched.DayHours) *dayHoursModel {
+	return &dayHoursModel{
+		StartTime: types.StringValue(formatClock(hours.StartTime)),
+		EndTime:   types.StringValue(formatClock(hours.EndTime)),
+	}
+}
+
+// parseClock converts an HH:MM time to the HHMM integer used by the API.
+func parseClock(s string) (int, error) {
+	if !clockPattern.MatchString(s) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	var hour, minute int
+	if _, err := fmt.Sscanf(s, "%d:%d", &hour, &minute); err != nil {
+		return 0, err
+	}
+	return hour*100 + minute, nil
+}
+
+// formatClock converts an HHMM integer from the API to HH:MM.
+func formatClock(t int) string {
+	return fmt.Sprintf("%02d:%02d", t/100, t%100)
+}
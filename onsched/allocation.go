@@ -0,0 +1,45 @@
+package onsched
+
+// Repeat frequencies of a ServiceAllocation.
+const (
+	RepeatDaily   = "D"
+	RepeatWeekly  = "W"
+	RepeatMonthly = "M"
+)
+
+// ServiceAllocation is a block of time during which a service is offered,
+// optionally limited to a location or resource and repeated on a schedule.
+type ServiceAllocation struct {
+	Object     string `json:"object"`
+	ID         string `json:"id"`
+	ServiceID  string `json:"serviceId"`
+	LocationID string `json:"locationId"`
+	ResourceID string `json:"resourceId"`
+	// StartDate and EndDate are the first and last day of the allocation in
+	// YYYY-MM-DD form. EndDate is empty for allocations repeating
+	// indefinitely.
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	// StartTime and EndTime are the daily interval in the HHMM form used by
+	// DayHours.
+	StartTime int `json:"startTime"`
+	EndTime   int `json:"endTime"`
+	// Capacity is the number of attendees each time slot accepts.
+	Capacity int `json:"capacity"`
+	// BookingLimit caps the total number of bookings, zero for no limit.
+	BookingLimit int              `json:"bookingLimit"`
+	Repeats      bool             `json:"repeats"`
+	Repeat       AllocationRepeat `json:"repeat"`
+	Deleted      bool             `json:"deleted"`
+}
+
+// AllocationRepeat is the recurrence of a repeating allocation.
+type AllocationRepeat struct {
+	// Frequency is one of RepeatDaily, RepeatWeekly or RepeatMonthly.
+	Frequency string `json:"frequency"`
+	// Interval is the number of days, weeks or months between occurrences.
+	Interval int `json:"interval"`
+	// Weekdays lists the days of weekly allocations as digits, 0 for Sunday
+	// through 6 for Saturday, e.g. "135" for Monday, Wednesday and Friday.
+	Weekdays string `json:"weekdays"`
+}
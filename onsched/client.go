@@ -4,14 +4,29 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
 
-	oauth2 "golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 type Client struct {
-	http    *http.Client
-	env     Environment
-	apiHost string
+	http     *http.Client
+	env      Environment
+	apiHost  string
+	tokenURL string
+	retry    RetryPolicy
+
+	oauth   *clientcredentials.Config
+	tokenMu sync.Mutex
+	token   *oauth2.Token
+
+	companyMu  sync.Mutex
+	locationMu keyedMutex
+	resourceMu keyedMutex
 }
 
 type Environment int64
@@ -21,12 +36,37 @@ const (
 	Prod
 )
 
+// Option configures optional behaviour of a Client.
+type Option func(*Client)
+
+// WithAPIURL overrides the base URL used for OnSched API requests, e.g. to
+// target a proxy or a local stand-in server.
+func WithAPIURL(url string) Option {
+	return func(c *Client) {
+		c.apiHost = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithTokenURL overrides the OAuth2 token endpoint used to authenticate.
+func WithTokenURL(url string) Option {
+	return func(c *Client) {
+		c.tokenURL = url
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for both API and token requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.http = client
+	}
+}
+
 func hostBuilder(service string, env Environment) string {
 	baseUrl := "onsched.com"
 	if env == Sandbox {
-		baseUrl = fmt.Sprintf("https://%s-%s.%s", "sandbox", service, baseUrl)
+		return fmt.Sprintf("https://%s-%s.%s", "sandbox", service, baseUrl)
 	}
-	return baseUrl
+	return fmt.Sprintf("https://%s.%s", service, baseUrl)
 }
 
 func apiHost(env Environment) string {
@@ -36,37 +76,492 @@ func identityHost(env Environment) string {
 	return hostBuilder("identity", env)
 }
 
-func NewClient(env Environment, client_id, client_secret string) *Client {
-	return NewClientWithContext(env, client_id, client_secret, context.Background())
-}
+// NewClient returns a client for the given environment. No request is made
+// until the first API call, which also fetches the access token using the
+// context of that call.
+func NewClient(env Environment, client_id, client_secret string, opts ...Option) *Client {
+	c := &Client{
+		http:     http.DefaultClient,
+		env:      env,
+		apiHost:  apiHost(env),
+		tokenURL: fmt.Sprintf("%s/connect/token", identityHost(env)),
+		retry:    DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
 
-func NewClientWithContext(env Environment, client_id, client_secret string, ctx context.Context) *Client {
-	url := identityHost(env)
-	conf := &oauth2.Config{
+	c.oauth = &clientcredentials.Config{
 		ClientID:     client_id,
 		ClientSecret: client_secret,
 		Scopes:       []string{"OnSchedApi"},
-		TokenURL:     fmt.Sprintf("%s/connect/token", url),
+		TokenURL:     c.tokenURL,
+	}
+	return c
+}
+
+// NewClientWithContext returns a client for the given environment. An
+// *http.Client stored in ctx under oauth2.HTTPClient is used for all requests;
+// ctx is otherwise unused.
+//
+// Deprecated: Use NewClient, with WithHTTPClient to set the HTTP client. Each
+// API call now takes its own context.
+func NewClientWithContext(env Environment, client_id, client_secret string, ctx context.Context, opts ...Option) *Client {
+	if client, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		opts = append([]Option{WithHTTPClient(client)}, opts...)
+	}
+	return NewClient(env, client_id, client_secret, opts...)
+}
+
+// accessToken returns a valid access token, fetching a new one with ctx when
+// the cached token is missing or expired.
+func (c *Client) accessToken(ctx context.Context) (*oauth2.Token, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token.Valid() {
+		return c.token, nil
 	}
-	return &Client{
-		http:    conf.Client(ctx),
-		env:     env,
-		apiHost: apiHost(env),
+
+	token, err := c.oauth.Token(context.WithValue(ctx, oauth2.HTTPClient, c.http))
+	if err != nil {
+		return nil, err
 	}
+	c.token = token
+	return token, nil
 }
 
-func (c *Client) GetCompany() (Company, error) {
-	result, err := c.get("setup/v1/companies")
+func (c *Client) GetCompany(ctx context.Context) (Company, error) {
+	result, err := c.get(ctx, "setup/v1/companies")
 	if err != nil {
 		return Company{}, err
 	}
 	return parse[Company](result)
 }
 
-func (c *Client) UpdateCompany(company Company) (Company, error) {
-	result, err := c.put("setup/v1/companies", company)
+func (c *Client) UpdateCompany(ctx context.Context, company Company) (Company, error) {
+	result, err := c.put(ctx, "setup/v1/companies", company)
 	if err != nil {
 		return Company{}, err
 	}
 	return parse[Company](result)
 }
+
+func (c *Client) GetLocation(ctx context.Context, id string) (Location, error) {
+	result, err := c.get(ctx, "setup/v1/locations/"+url.PathEscape(id))
+	if err != nil {
+		return Location{}, err
+	}
+	return parse[Location](result)
+}
+
+func (c *Client) CreateLocation(ctx context.Context, location Location) (Location, error) {
+	result, err := c.post(ctx, "setup/v1/locations", location)
+	if err != nil {
+		return Location{}, err
+	}
+	return parse[Location](result)
+}
+
+func (c *Client) UpdateLocation(ctx context.Context, location Location) (Location, error) {
+	result, err := c.put(ctx, "setup/v1/locations/"+url.PathEscape(location.ID), location)
+	if err != nil {
+		return Location{}, err
+	}
+	return parse[Location](result)
+}
+
+func (c *Client) DeleteLocation(ctx context.Context, id string) error {
+	_, err := c.delete(ctx, "setup/v1/locations/"+url.PathEscape(id))
+	return err
+}
+
+// MutateLocation applies mutate to the current location with the given ID
+// and saves the result. Calls for the same location are serialized per
+// client, so that read-modify-write cycles such as UpdateBusinessHours don't
+// overwrite each other's changes.
+func (c *Client) MutateLocation(ctx context.Context, id string, mutate func(*Location) error) (Location, error) {
+	defer c.locationMu.lock(id)()
+
+	location, err := c.GetLocation(ctx, id)
+	if err != nil {
+		return Location{}, err
+	}
+	if err := mutate(&location); err != nil {
+		return Location{}, err
+	}
+	return c.UpdateLocation(ctx, location)
+}
+
+// GetBusinessHours returns the business hours of the location with the
+// given ID.
+func (c *Client) GetBusinessHours(ctx context.Context, locationID string) (BusinessHours, error) {
+	location, err := c.GetLocation(ctx, locationID)
+	if err != nil {
+		return BusinessHours{}, err
+	}
+	return location.BusinessHours, nil
+}
+
+// UpdateBusinessHours replaces the business hours of the location with the
+// given ID. OnSched stores them on the location, so the location is fetched
+// and saved again with only its hours changed, see MutateLocation.
+func (c *Client) UpdateBusinessHours(ctx context.Context, locationID string, hours BusinessHours) (BusinessHours, error) {
+	if err := hours.Validate(); err != nil {
+		return BusinessHours{}, err
+	}
+
+	location, err := c.MutateLocation(ctx, locationID, func(location *Location) error {
+		location.BusinessHours = hours
+		return nil
+	})
+	if err != nil {
+		return BusinessHours{}, err
+	}
+	return location.BusinessHours, nil
+}
+
+// ListHolidays returns the holidays of the location with the given ID.
+func (c *Client) ListHolidays(ctx context.Context, locationID string) ([]Holiday, error) {
+	return list[Holiday](ctx, c, holidaysPath(locationID))
+}
+
+func (c *Client) GetHoliday(ctx context.Context, locationID, id string) (Holiday, error) {
+	result, err := c.get(ctx, holidaysPath(locationID)+"/"+url.PathEscape(id))
+	if err != nil {
+		return Holiday{}, err
+	}
+	return parse[Holiday](result)
+}
+
+func (c *Client) CreateHoliday(ctx context.Context, holiday Holiday) (Holiday, error) {
+	result, err := c.post(ctx, holidaysPath(holiday.LocationID), holiday)
+	if err != nil {
+		return Holiday{}, err
+	}
+	return parse[Holiday](result)
+}
+
+func (c *Client) UpdateHoliday(ctx context.Context, holiday Holiday) (Holiday, error) {
+	result, err := c.put(ctx, holidaysPath(holiday.LocationID)+"/"+url.PathEscape(holiday.ID), holiday)
+	if err != nil {
+		return Holiday{}, err
+	}
+	return parse[Holiday](result)
+}
+
+func (c *Client) DeleteHoliday(ctx context.Context, locationID, id string) error {
+	_, err := c.delete(ctx, holidaysPath(locationID)+"/"+url.PathEscape(id))
+	return err
+}
+
+func holidaysPath(locationID string) string {
+	return "setup/v1/locations/" + url.PathEscape(locationID) + "/holidays"
+}
+
+func (c *Client) GetService(ctx context.Context, id string) (Service, error) {
+	result, err := c.get(ctx, "setup/v1/services/"+url.PathEscape(id))
+	if err != nil {
+		return Service{}, err
+	}
+	return parse[Service](result)
+}
+
+func (c *Client) CreateService(ctx context.Context, service Service) (Service, error) {
+	result, err := c.post(ctx, "setup/v1/services", service)
+	if err != nil {
+		return Service{}, err
+	}
+	return parse[Service](result)
+}
+
+func (c *Client) UpdateService(ctx context.Context, service Service) (Service, error) {
+	result, err := c.put(ctx, "setup/v1/services/"+url.PathEscape(service.ID), service)
+	if err != nil {
+		return Service{}, err
+	}
+	return parse[Service](result)
+}
+
+func (c *Client) DeleteService(ctx context.Context, id string) error {
+	_, err := c.delete(ctx, "setup/v1/services/"+url.PathEscape(id))
+	return err
+}
+
+// GetServiceResources returns the resources that can perform the service
+// with the given ID.
+func (c *Client) GetServiceResources(ctx context.Context, serviceID string) ([]Resource, error) {
+	return list[Resource](ctx, c, "setup/v1/services/"+url.PathEscape(serviceID)+"/resources")
+}
+
+// AddServiceResource allows the resource to perform the service.
+func (c *Client) AddServiceResource(ctx context.Context, serviceID, resourceID string) error {
+	_, err := c.post(ctx, "setup/v1/services/"+url.PathEscape(serviceID)+"/resources", resourceLink{ResourceID: resourceID})
+	return err
+}
+
+// RemoveServiceResource stops the resource from performing the service.
+func (c *Client) RemoveServiceResource(ctx context.Context, serviceID, resourceID string) error {
+	_, err := c.delete(ctx, "setup/v1/services/"+url.PathEscape(serviceID)+"/resources/"+url.PathEscape(resourceID))
+	return err
+}
+
+// ListServiceAllocations returns the allocations of the service with the
+// given ID.
+func (c *Client) ListServiceAllocations(ctx context.Context, serviceID string) ([]ServiceAllocation, error) {
+	return list[ServiceAllocation](ctx, c, allocationsPath(serviceID))
+}
+
+func (c *Client) GetServiceAllocation(ctx context.Context, serviceID, id string) (ServiceAllocation, error) {
+	result, err := c.get(ctx, allocationsPath(serviceID)+"/"+url.PathEscape(id))
+	if err != nil {
+		return ServiceAllocation{}, err
+	}
+	return parse[ServiceAllocation](result)
+}
+
+func (c *Client) CreateServiceAllocation(ctx context.Context, allocation ServiceAllocation) (ServiceAllocation, error) {
+	result, err := c.post(ctx, allocationsPath(allocation.ServiceID), allocation)
+	if err != nil {
+		return ServiceAllocation{}, err
+	}
+	return parse[ServiceAllocation](result)
+}
+
+func (c *Client) UpdateServiceAllocation(ctx context.Context, allocation ServiceAllocation) (ServiceAllocation, error) {
+	result, err := c.put(ctx, allocationsPath(allocation.ServiceID)+"/"+url.PathEscape(allocation.ID), allocation)
+	if err != nil {
+		return ServiceAllocation{}, err
+	}
+	return parse[ServiceAllocation](result)
+}
+
+func (c *Client) DeleteServiceAllocation(ctx context.Context, serviceID, id string) error {
+	_, err := c.delete(ctx, allocationsPath(serviceID)+"/"+url.PathEscape(id))
+	return err
+}
+
+func allocationsPath(serviceID string) string {
+	return "setup/v1/services/" + url.PathEscape(serviceID) + "/allocations"
+}
+
+func (c *Client) GetServiceGroup(ctx context.Context, id string) (ServiceGroup, error) {
+	result, err := c.get(ctx, "setup/v1/servicegroups/"+url.PathEscape(id))
+	if err != nil {
+		return ServiceGroup{}, err
+	}
+	return parse[ServiceGroup](result)
+}
+
+func (c *Client) CreateServiceGroup(ctx context.Context, group ServiceGroup) (ServiceGroup, error) {
+	result, err := c.post(ctx, "setup/v1/servicegroups", group)
+	if err != nil {
+		return ServiceGroup{}, err
+	}
+	return parse[ServiceGroup](result)
+}
+
+func (c *Client) UpdateServiceGroup(ctx context.Context, group ServiceGroup) (ServiceGroup, error) {
+	result, err := c.put(ctx, "setup/v1/servicegroups/"+url.PathEscape(group.ID), group)
+	if err != nil {
+		return ServiceGroup{}, err
+	}
+	return parse[ServiceGroup](result)
+}
+
+func (c *Client) DeleteServiceGroup(ctx context.Context, id string) error {
+	_, err := c.delete(ctx, "setup/v1/servicegroups/"+url.PathEscape(id))
+	return err
+}
+
+func (c *Client) GetResource(ctx context.Context, id string) (Resource, error) {
+	result, err := c.get(ctx, "setup/v1/resources/"+url.PathEscape(id))
+	if err != nil {
+		return Resource{}, err
+	}
+	return parse[Resource](result)
+}
+
+func (c *Client) CreateResource(ctx context.Context, resource Resource) (Resource, error) {
+	result, err := c.post(ctx, "setup/v1/resources", resource)
+	if err != nil {
+		return Resource{}, err
+	}
+	return parse[Resource](result)
+}
+
+func (c *Client) UpdateResource(ctx context.Context, resource Resource) (Resource, error) {
+	result, err := c.put(ctx, "setup/v1/resources/"+url.PathEscape(resource.ID), resource)
+	if err != nil {
+		return Resource{}, err
+	}
+	return parse[Resource](result)
+}
+
+func (c *Client) DeleteResource(ctx context.Context, id string) error {
+	_, err := c.delete(ctx, "setup/v1/resources/"+url.PathEscape(id))
+	return err
+}
+
+// MutateResource applies mutate to the current resource with the given ID
+// and saves the result. Calls for the same resource are serialized per
+// client, so that read-modify-write cycles such as UpdateResourceAvailability
+// don't overwrite each other's changes.
+func (c *Client) MutateResource(ctx context.Context, id string, mutate func(*Resource) error) (Resource, error) {
+	defer c.resourceMu.lock(id)()
+
+	resource, err := c.GetResource(ctx, id)
+	if err != nil {
+		return Resource{}, err
+	}
+	if err := mutate(&resource); err != nil {
+		return Resource{}, err
+	}
+	return c.UpdateResource(ctx, resource)
+}
+
+// GetResourceAvailability returns the weekly availability of the resource
+// with the given ID.
+func (c *Client) GetResourceAvailability(ctx context.Context, resourceID string) (BusinessHours, error) {
+	resource, err := c.GetResource(ctx, resourceID)
+	if err != nil {
+		return BusinessHours{}, err
+	}
+	return resource.Availability, nil
+}
+
+// UpdateResourceAvailability replaces the weekly availability of the
+// resource with the given ID. Like business hours, it is stored on the
+// resource, which is fetched and saved again with only its availability
+// changed, see MutateResource.
+func (c *Client) UpdateResourceAvailability(ctx context.Context, resourceID string, availability BusinessHours) (BusinessHours, error) {
+	if err := availability.Validate(); err != nil {
+		return BusinessHours{}, err
+	}
+
+	resource, err := c.MutateResource(ctx, resourceID, func(resource *Resource) error {
+		resource.Availability = availability
+		return nil
+	})
+	if err != nil {
+		return BusinessHours{}, err
+	}
+	return resource.Availability, nil
+}
+
+// GetEffectiveAvailability returns the hours during which the resource with
+// the given ID can be booked: its availability limited to the business hours
+// of its location. Holidays are not taken into account.
+func (c *Client) GetEffectiveAvailability(ctx context.Context, resourceID string) (BusinessHours, error) {
+	resource, err := c.GetResource(ctx, resourceID)
+	if err != nil {
+		return BusinessHours{}, err
+	}
+	if resource.LocationID == "" {
+		return resource.Availability, nil
+	}
+
+	hours, err := c.GetBusinessHours(ctx, resource.LocationID)
+	if err != nil {
+		return BusinessHours{}, err
+	}
+	return resource.Availability.Intersect(hours), nil
+}
+
+func (c *Client) GetResourceGroup(ctx context.Context, id string) (ResourceGroup, error) {
+	result, err := c.get(ctx, resourceGroupPath(id))
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+	return parse[ResourceGroup](result)
+}
+
+func (c *Client) CreateResourceGroup(ctx context.Context, group ResourceGroup) (ResourceGroup, error) {
+	result, err := c.post(ctx, "setup/v1/resourcegroups", group)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+	return parse[ResourceGroup](result)
+}
+
+func (c *Client) UpdateResourceGroup(ctx context.Context, group ResourceGroup) (ResourceGroup, error) {
+	result, err := c.put(ctx, resourceGroupPath(group.ID), group)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+	return parse[ResourceGroup](result)
+}
+
+func (c *Client) DeleteResourceGroup(ctx context.Context, id string) error {
+	_, err := c.delete(ctx, resourceGroupPath(id))
+	return err
+}
+
+// GetResourceGroupMembers returns the resources in the group with the given
+// ID.
+func (c *Client) GetResourceGroupMembers(ctx context.Context, groupID string) ([]Resource, error) {
+	return list[Resource](ctx, c, resourceGroupPath(groupID)+"/resources")
+}
+
+// AddResourceGroupMember adds the resource to the group.
+func (c *Client) AddResourceGroupMember(ctx context.Context, groupID, resourceID string) error {
+	_, err := c.post(ctx, resourceGroupPath(groupID)+"/resources", resourceLink{ResourceID: resourceID})
+	return err
+}
+
+// RemoveResourceGroupMember removes the resource from the group.
+func (c *Client) RemoveResourceGroupMember(ctx context.Context, groupID, resourceID string) error {
+	_, err := c.delete(ctx, resourceGroupPath(groupID)+"/resources/"+url.PathEscape(resourceID))
+	return err
+}
+
+func resourceGroupPath(id string) string {
+	return "setup/v1/resourcegroups/" + url.PathEscape(id)
+}
+
+// MutateCompany applies mutate to the current company and saves the result.
+// Calls are serialized per client so that concurrent read-modify-write
+// cycles on the company object don't overwrite each other's changes.
+//
+// check, if not nil, is called with the current company before mutate and
+// returns the JSON names of the fields that no longer hold the values the
+// caller last read, typically computed with ChangedFields from its prior
+// state. If any are returned, a *ConflictError is returned without saving,
+// so that changes made outside of this client since the caller's last read
+// are not overwritten. As the API has no conditional updates, a change made
+// between the read and the save below still goes unnoticed.
+func (c *Client) MutateCompany(ctx context.Context, check func(current Company) []string, mutate func(*Company) error) (Company, error) {
+	c.companyMu.Lock()
+	defer c.companyMu.Unlock()
+
+	company, err := c.GetCompany(ctx)
+	if err != nil {
+		return Company{}, err
+	}
+
+	if check != nil {
+		if changed := check(company); len(changed) > 0 {
+			return Company{}, &ConflictError{Fields: changed}
+		}
+	}
+
+	if err := mutate(&company); err != nil {
+		return Company{}, err
+	}
+
+	return c.UpdateCompany(ctx, company)
+}
+
+// ChangedFields returns the JSON names of the fields that differ between a
+// and b.
+func ChangedFields(a, b Company) []string {
+	var changed []string
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	for i := 0; i < va.NumField(); i++ {
+		if va.Field(i).Interface() != vb.Field(i).Interface() {
+			name, _, _ := strings.Cut(va.Type().Field(i).Tag.Get("json"), ",")
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,1119 @@
+package onsched
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const testAccessToken = "eyJhbGciOiJSUzI1NiIsImtpZCI6IjEyMyJ9.fixture"
+
+// fixture returns the contents of testdata/name.
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+// fixtureValue decodes testdata/name into a T.
+func fixtureValue[T any](t *testing.T, name string) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(fixture(t, name), &v); err != nil {
+		t.Fatalf("decoding %s: %v", name, err)
+	}
+	return v
+}
+
+// newTestClient returns a client talking to a server that issues tokens
+// from testdata/token.json and passes every other request to handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/connect/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok {
+			id, secret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+		}
+		if id != "client" || secret != "secret" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write(fixture(t, "error_token.json"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(fixture(t, "token.json"))
+	})
+	mux.Handle("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	opts = append([]Option{
+		WithAPIURL(server.URL),
+		WithTokenURL(server.URL + "/connect/token"),
+		WithRetryPolicy(RetryPolicy{}),
+	}, opts...)
+	return NewClient(Sandbox, "client", "secret", opts...)
+}
+
+// respond returns a handler writing status and body as JSON.
+func respond(status int, body []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}
+}
+
+// assertJSONEqual fails the test unless got and want hold the same JSON
+// document, ignoring formatting and key order.
+func assertJSONEqual(t *testing.T, got, want []byte) {
+	t.Helper()
+	var g, w any
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("invalid JSON %q: %v", got, err)
+	}
+	if err := json.Unmarshal(want, &w); err != nil {
+		t.Fatalf("invalid JSON %q: %v", want, err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("JSON mismatch\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestNewClientWithContext(t *testing.T) {
+	httpClient := &http.Client{}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+
+	client := NewClientWithContext(Prod, "client", "secret", ctx, WithAPIURL("https://api.example"))
+	if client.http != httpClient {
+		t.Error("HTTP client from context not used")
+	}
+	if client.apiHost != "https://api.example" || client.env != Prod {
+		t.Errorf("options not applied: %+v", client)
+	}
+
+	client = NewClientWithContext(Sandbox, "client", "secret", context.Background())
+	if client.http != http.DefaultClient {
+		t.Error("expected the default HTTP client")
+	}
+}
+
+func TestClientEndpoints(t *testing.T) {
+	company := fixtureValue[Company](t, "company.json")
+	location := fixtureValue[Location](t, "location.json")
+	service := fixtureValue[Service](t, "service.json")
+	resource := fixtureValue[Resource](t, "resource.json")
+	holiday := fixtureValue[Holiday](t, "holiday.json")
+	group := fixtureValue[ServiceGroup](t, "service_group.json")
+	resourceGroup := fixtureValue[ResourceGroup](t, "resource_group.json")
+	allocation := fixtureValue[ServiceAllocation](t, "allocation.json")
+
+	tests := []struct {
+		name string
+		call func(context.Context, *Client) (any, error)
+		// method and path are the expected request line.
+		method, path string
+		// body is the fixture expected as request body, if any.
+		body string
+		// response is the fixture returned by the server.
+		response string
+		// want is the expected result, nil for calls returning only an
+		// error.
+		want any
+		// partial is set when want holds only part of the response.
+		partial bool
+	}{
+		{
+			name:     "GetCompany",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetCompany(ctx) },
+			method:   "GET",
+			path:     "/setup/v1/companies",
+			response: "company.json",
+			want:     company,
+		},
+		{
+			name:     "UpdateCompany",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateCompany(ctx, company) },
+			method:   "PUT",
+			path:     "/setup/v1/companies",
+			body:     "company.json",
+			response: "company.json",
+			want:     company,
+		},
+		{
+			name:     "GetLocation",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetLocation(ctx, "12") },
+			method:   "GET",
+			path:     "/setup/v1/locations/12",
+			response: "location.json",
+			want:     location,
+		},
+		{
+			name:     "GetLocation escapes ID",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetLocation(ctx, "a/b c") },
+			method:   "GET",
+			path:     "/setup/v1/locations/a%2Fb%20c",
+			response: "location.json",
+			want:     location,
+		},
+		{
+			name:     "GetBusinessHours",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetBusinessHours(ctx, "12") },
+			method:   "GET",
+			path:     "/setup/v1/locations/12",
+			response: "location.json",
+			want:     location.BusinessHours,
+			partial:  true,
+		},
+		{
+			name:     "CreateLocation",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.CreateLocation(ctx, location) },
+			method:   "POST",
+			path:     "/setup/v1/locations",
+			body:     "location.json",
+			response: "location.json",
+			want:     location,
+		},
+		{
+			name:     "UpdateLocation",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateLocation(ctx, location) },
+			method:   "PUT",
+			path:     "/setup/v1/locations/12",
+			body:     "location.json",
+			response: "location.json",
+			want:     location,
+		},
+		{
+			name:     "DeleteLocation",
+			call:     func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteLocation(ctx, "12") },
+			method:   "DELETE",
+			path:     "/setup/v1/locations/12",
+			response: "location.json",
+		},
+		{
+			name:     "ListHolidays",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.ListHolidays(ctx, "12") },
+			method:   "GET",
+			path:     "/setup/v1/locations/12/holidays",
+			response: "holidays.json",
+			want:     fixtureValue[listResponse[Holiday]](t, "holidays.json").Data,
+			partial:  true,
+		},
+		{
+			name:     "GetHoliday",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetHoliday(ctx, "12", "78") },
+			method:   "GET",
+			path:     "/setup/v1/locations/12/holidays/78",
+			response: "holiday.json",
+			want:     holiday,
+		},
+		{
+			name:     "CreateHoliday",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.CreateHoliday(ctx, holiday) },
+			method:   "POST",
+			path:     "/setup/v1/locations/12/holidays",
+			body:     "holiday.json",
+			response: "holiday.json",
+			want:     holiday,
+		},
+		{
+			name:     "UpdateHoliday",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateHoliday(ctx, holiday) },
+			method:   "PUT",
+			path:     "/setup/v1/locations/12/holidays/78",
+			body:     "holiday.json",
+			response: "holiday.json",
+			want:     holiday,
+		},
+		{
+			name:     "DeleteHoliday",
+			call:     func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteHoliday(ctx, "12", "78") },
+			method:   "DELETE",
+			path:     "/setup/v1/locations/12/holidays/78",
+			response: "holiday.json",
+		},
+		{
+			name:     "GetService",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetService(ctx, "34") },
+			method:   "GET",
+			path:     "/setup/v1/services/34",
+			response: "service.json",
+			want:     service,
+		},
+		{
+			name:     "CreateService",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.CreateService(ctx, service) },
+			method:   "POST",
+			path:     "/setup/v1/services",
+			body:     "service.json",
+			response: "service.json",
+			want:     service,
+		},
+		{
+			name:     "UpdateService",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateService(ctx, service) },
+			method:   "PUT",
+			path:     "/setup/v1/services/34",
+			body:     "service.json",
+			response: "service.json",
+			want:     service,
+		},
+		{
+			name:     "DeleteService",
+			call:     func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteService(ctx, "34") },
+			method:   "DELETE",
+			path:     "/setup/v1/services/34",
+			response: "service.json",
+		},
+		{
+			name:     "GetServiceResources",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetServiceResources(ctx, "34") },
+			method:   "GET",
+			path:     "/setup/v1/services/34/resources",
+			response: "resources.json",
+			want:     []Resource{resource},
+			partial:  true,
+		},
+		{
+			name:     "AddServiceResource",
+			call:     func(ctx context.Context, c *Client) (any, error) { return nil, c.AddServiceResource(ctx, "34", "56") },
+			method:   "POST",
+			path:     "/setup/v1/services/34/resources",
+			body:     "resource_link.json",
+			response: "resource.json",
+		},
+		{
+			name: "RemoveServiceResource",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return nil, c.RemoveServiceResource(ctx, "34", "56")
+			},
+			method:   "DELETE",
+			path:     "/setup/v1/services/34/resources/56",
+			response: "resource.json",
+		},
+		{
+			name:     "ListServiceAllocations",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.ListServiceAllocations(ctx, "34") },
+			method:   "GET",
+			path:     "/setup/v1/services/34/allocations",
+			response: "allocations.json",
+			want:     []ServiceAllocation{allocation},
+			partial:  true,
+		},
+		{
+			name:     "GetServiceAllocation",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetServiceAllocation(ctx, "34", "67") },
+			method:   "GET",
+			path:     "/setup/v1/services/34/allocations/67",
+			response: "allocation.json",
+			want:     allocation,
+		},
+		{
+			name:     "CreateServiceAllocation",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.CreateServiceAllocation(ctx, allocation) },
+			method:   "POST",
+			path:     "/setup/v1/services/34/allocations",
+			body:     "allocation.json",
+			response: "allocation.json",
+			want:     allocation,
+		},
+		{
+			name:     "UpdateServiceAllocation",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateServiceAllocation(ctx, allocation) },
+			method:   "PUT",
+			path:     "/setup/v1/services/34/allocations/67",
+			body:     "allocation.json",
+			response: "allocation.json",
+			want:     allocation,
+		},
+		{
+			name: "DeleteServiceAllocation",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return nil, c.DeleteServiceAllocation(ctx, "34", "67")
+			},
+			method:   "DELETE",
+			path:     "/setup/v1/services/34/allocations/67",
+			response: "allocation.json",
+		},
+		{
+			name:     "GetServiceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetServiceGroup(ctx, "90") },
+			method:   "GET",
+			path:     "/setup/v1/servicegroups/90",
+			response: "service_group.json",
+			want:     group,
+		},
+		{
+			name:     "CreateServiceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.CreateServiceGroup(ctx, group) },
+			method:   "POST",
+			path:     "/setup/v1/servicegroups",
+			body:     "service_group.json",
+			response: "service_group.json",
+			want:     group,
+		},
+		{
+			name:     "UpdateServiceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateServiceGroup(ctx, group) },
+			method:   "PUT",
+			path:     "/setup/v1/servicegroups/90",
+			body:     "service_group.json",
+			response: "service_group.json",
+			want:     group,
+		},
+		{
+			name:     "DeleteServiceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteServiceGroup(ctx, "90") },
+			method:   "DELETE",
+			path:     "/setup/v1/servicegroups/90",
+			response: "service_group.json",
+		},
+		{
+			name:     "GetResource",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetResource(ctx, "56") },
+			method:   "GET",
+			path:     "/setup/v1/resources/56",
+			response: "resource.json",
+			want:     resource,
+		},
+		{
+			name:     "GetResourceAvailability",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetResourceAvailability(ctx, "56") },
+			method:   "GET",
+			path:     "/setup/v1/resources/56",
+			response: "resource.json",
+			want:     resource.Availability,
+			partial:  true,
+		},
+		{
+			name:     "CreateResource",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.CreateResource(ctx, resource) },
+			method:   "POST",
+			path:     "/setup/v1/resources",
+			body:     "resource.json",
+			response: "resource.json",
+			want:     resource,
+		},
+		{
+			name:     "UpdateResource",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateResource(ctx, resource) },
+			method:   "PUT",
+			path:     "/setup/v1/resources/56",
+			body:     "resource.json",
+			response: "resource.json",
+			want:     resource,
+		},
+		{
+			name:     "DeleteResource",
+			call:     func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteResource(ctx, "56") },
+			method:   "DELETE",
+			path:     "/setup/v1/resources/56",
+			response: "resource.json",
+		},
+		{
+			name:     "GetResourceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetResourceGroup(ctx, "91") },
+			method:   "GET",
+			path:     "/setup/v1/resourcegroups/91",
+			response: "resource_group.json",
+			want:     resourceGroup,
+		},
+		{
+			name:     "CreateResourceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.CreateResourceGroup(ctx, resourceGroup) },
+			method:   "POST",
+			path:     "/setup/v1/resourcegroups",
+			body:     "resource_group.json",
+			response: "resource_group.json",
+			want:     resourceGroup,
+		},
+		{
+			name:     "UpdateResourceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.UpdateResourceGroup(ctx, resourceGroup) },
+			method:   "PUT",
+			path:     "/setup/v1/resourcegroups/91",
+			body:     "resource_group.json",
+			response: "resource_group.json",
+			want:     resourceGroup,
+		},
+		{
+			name:     "DeleteResourceGroup",
+			call:     func(ctx context.Context, c *Client) (any, error) { return nil, c.DeleteResourceGroup(ctx, "91") },
+			method:   "DELETE",
+			path:     "/setup/v1/resourcegroups/91",
+			response: "resource_group.json",
+		},
+		{
+			name:     "GetResourceGroupMembers",
+			call:     func(ctx context.Context, c *Client) (any, error) { return c.GetResourceGroupMembers(ctx, "91") },
+			method:   "GET",
+			path:     "/setup/v1/resourcegroups/91/resources",
+			response: "resources.json",
+			want:     []Resource{resource},
+			partial:  true,
+		},
+		{
+			name: "AddResourceGroupMember",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return nil, c.AddResourceGroupMember(ctx, "91", "56")
+			},
+			method:   "POST",
+			path:     "/setup/v1/resourcegroups/91/resources",
+			body:     "resource_link.json",
+			response: "resource.json",
+		},
+		{
+			name: "RemoveResourceGroupMember",
+			call: func(ctx context.Context, c *Client) (any, error) {
+				return nil, c.RemoveResourceGroupMember(ctx, "91", "56")
+			},
+			method:   "DELETE",
+			path:     "/setup/v1/resourcegroups/91/resources/56",
+			response: "resource.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+
+				if r.Method != tt.method {
+					t.Errorf("method = %s, want %s", r.Method, tt.method)
+				}
+				if got := r.URL.EscapedPath(); got != tt.path {
+					t.Errorf("path = %s, want %s", got, tt.path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer "+testAccessToken {
+					t.Errorf("Authorization = %q", got)
+				}
+
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if tt.body == "" {
+					if len(body) != 0 {
+						t.Errorf("unexpected request body %s", body)
+					}
+				} else {
+					if got := r.Header.Values("Content-Type"); !reflect.DeepEqual(got, []string{"application/json"}) {
+						t.Errorf("Content-Type = %q, want a single application/json", got)
+					}
+					assertJSONEqual(t, body, fixture(t, tt.body))
+				}
+
+				respond(http.StatusOK, fixture(t, tt.response))(w, r)
+			})
+
+			got, err := tt.call(context.Background(), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requests != 1 {
+				t.Errorf("sent %d requests, want 1", requests)
+			}
+			if tt.want == nil {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+			if tt.partial {
+				return
+			}
+
+			// Every field of the recorded response must round-trip.
+			content, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertJSONEqual(t, content, fixture(t, tt.response))
+		})
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		secret  string
+		check   func(error) bool
+		// apiErr is the expected *APIError, if any.
+		apiErr *APIError
+	}{
+		{
+			name: "not found",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-1")
+				respond(http.StatusNotFound, fixture(t, "error_not_found.json"))(w, r)
+			},
+			check: IsNotFound,
+			apiErr: &APIError{
+				StatusCode: http.StatusNotFound,
+				Method:     "GET",
+				Path:       "/setup/v1/locations/99",
+				RequestID:  "req-1",
+				Code:       "not_found",
+				Message:    "Location 99 not found",
+			},
+		},
+		{
+			name:    "unauthorized",
+			handler: respond(http.StatusUnauthorized, []byte(`{"error":"invalid_token"}`)),
+			check:   IsUnauthorized,
+			apiErr: &APIError{
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/setup/v1/locations/99",
+				Message:    "invalid_token",
+			},
+		},
+		{
+			name:    "forbidden",
+			handler: respond(http.StatusForbidden, nil),
+			check:   IsForbidden,
+		},
+		{
+			name:    "conflict",
+			handler: respond(http.StatusConflict, nil),
+			check:   IsConflict,
+		},
+		{
+			name:    "rate limited",
+			handler: respond(http.StatusTooManyRequests, nil),
+			check:   IsRateLimited,
+		},
+		{
+			name: "plain text body",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "upstream exploded", http.StatusInternalServerError)
+			},
+			apiErr: &APIError{
+				StatusCode: http.StatusInternalServerError,
+				Method:     "GET",
+				Path:       "/setup/v1/locations/99",
+				Message:    "upstream exploded",
+			},
+		},
+		{
+			name:    "invalid client credentials",
+			handler: func(w http.ResponseWriter, r *http.Request) { t.Error("unexpected API request") },
+			secret:  "wrong",
+			check:   IsUnauthorized,
+		},
+		{
+			name:    "malformed response",
+			handler: respond(http.StatusOK, []byte(`{"id": 12`)),
+			check: func(err error) bool {
+				return strings.HasPrefix(err.Error(), "decoding response:")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.handler)
+			if tt.secret != "" {
+				client.oauth.ClientSecret = tt.secret
+			}
+
+			_, err := client.GetLocation(context.Background(), "99")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.check != nil && !tt.check(err) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if tt.apiErr != nil {
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("expected *APIError, got %T: %v", err, err)
+				}
+				apiErr.Body = nil
+				if !reflect.DeepEqual(apiErr, tt.apiErr) {
+					t.Errorf("got %+v, want %+v", apiErr, tt.apiErr)
+				}
+			}
+		})
+	}
+}
+
+func TestClientReusesToken(t *testing.T) {
+	var tokens int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/connect/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokens, 1)
+		respond(http.StatusOK, fixture(t, "token.json"))(w, r)
+	})
+	mux.Handle("/", respond(http.StatusOK, fixture(t, "company.json")))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewClient(Sandbox, "client", "secret", WithAPIURL(server.URL), WithTokenURL(server.URL+"/connect/token"))
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetCompany(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tokens != 1 {
+		t.Errorf("requested %d tokens, want 1", tokens)
+	}
+}
+
+func TestMutateCompany(t *testing.T) {
+	original := fixture(t, "company.json")
+
+	t.Run("saves mutated company", func(t *testing.T) {
+		var put []byte
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				put, _ = io.ReadAll(r.Body)
+				respond(http.StatusOK, put)(w, r)
+				return
+			}
+			respond(http.StatusOK, original)(w, r)
+		})
+
+		company, err := client.MutateCompany(context.Background(), nil, func(c *Company) error {
+			c.ReminderWebhookURL = "https://hooks.acme.example/reminders"
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if company.ReminderWebhookURL != "https://hooks.acme.example/reminders" {
+			t.Errorf("ReminderWebhookURL = %q", company.ReminderWebhookURL)
+		}
+		if sent := fixtureValue[Company](t, "company.json"); json.Unmarshal(put, &sent) != nil || sent.ReminderWebhookURL != company.ReminderWebhookURL {
+			t.Errorf("unexpected request body %s", put)
+		}
+	})
+
+	t.Run("detects change since last read", func(t *testing.T) {
+		// The caller last read the fixture, then the name and city were
+		// changed remotely.
+		prior := fixtureValue[Company](t, "company.json")
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("unexpected %s request", r.Method)
+				return
+			}
+			company := fixtureValue[Company](t, "company.json")
+			company.Name = "Changed elsewhere"
+			company.City = "Montreal"
+			content, _ := json.Marshal(company)
+			respond(http.StatusOK, content)(w, r)
+		})
+
+		var mutated bool
+		_, err := client.MutateCompany(context.Background(), func(current Company) []string {
+			return ChangedFields(prior, current)
+		}, func(c *Company) error {
+			mutated = true
+			c.City = "Ottawa"
+			return nil
+		})
+		var conflict *ConflictError
+		if !errors.As(err, &conflict) {
+			t.Fatalf("expected *ConflictError, got %v", err)
+		}
+		if !reflect.DeepEqual(conflict.Fields, []string{"name", "city"}) {
+			t.Errorf("Fields = %q", conflict.Fields)
+		}
+		if mutated {
+			t.Error("mutate was called despite the conflict")
+		}
+	})
+
+	t.Run("ignores changes to fields not checked", func(t *testing.T) {
+		prior := fixtureValue[Company](t, "company.json")
+		var gets, puts int
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				puts++
+				body, _ := io.ReadAll(r.Body)
+				respond(http.StatusOK, body)(w, r)
+				return
+			}
+			gets++
+			company := fixtureValue[Company](t, "company.json")
+			company.Name = "Changed elsewhere"
+			content, _ := json.Marshal(company)
+			respond(http.StatusOK, content)(w, r)
+		})
+
+		company, err := client.MutateCompany(context.Background(), func(current Company) []string {
+			if current.City != prior.City {
+				return []string{"city"}
+			}
+			return nil
+		}, func(c *Company) error {
+			c.City = "Ottawa"
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if company.Name != "Changed elsewhere" || company.City != "Ottawa" {
+			t.Errorf("company = %+v", company)
+		}
+		if gets != 1 || puts != 1 {
+			t.Errorf("made %d GET and %d PUT requests, want 1 each", gets, puts)
+		}
+	})
+
+	t.Run("propagates mutate error", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("unexpected %s request", r.Method)
+			}
+			respond(http.StatusOK, original)(w, r)
+		})
+
+		want := errors.New("boom")
+		_, err := client.MutateCompany(context.Background(), nil, func(*Company) error { return want })
+		if !errors.Is(err, want) {
+			t.Errorf("got %v, want %v", err, want)
+		}
+	})
+}
+
+func TestUpdateBusinessHours(t *testing.T) {
+	hours := BusinessHours{Mon: DayHours{StartTime: 800, EndTime: 1200}}
+
+	t.Run("saves location with new hours", func(t *testing.T) {
+		var methods []string
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			methods = append(methods, r.Method)
+			if r.URL.Path != "/setup/v1/locations/12" {
+				t.Errorf("path = %s", r.URL.Path)
+			}
+			if r.Method == http.MethodPut {
+				var location Location
+				if err := json.NewDecoder(r.Body).Decode(&location); err != nil {
+					t.Fatal(err)
+				}
+				want := fixtureValue[Location](t, "location.json")
+				want.BusinessHours = hours
+				if !reflect.DeepEqual(location, want) {
+					t.Errorf("saved %+v, want %+v", location, want)
+				}
+				content, _ := json.Marshal(location)
+				respond(http.StatusOK, content)(w, r)
+				return
+			}
+			respond(http.StatusOK, fixture(t, "location.json"))(w, r)
+		})
+
+		got, err := client.UpdateBusinessHours(context.Background(), "12", hours)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != hours {
+			t.Errorf("got %+v, want %+v", got, hours)
+		}
+		if !reflect.DeepEqual(methods, []string{"GET", "PUT"}) {
+			t.Errorf("requests = %q, want GET then PUT", methods)
+		}
+	})
+
+	t.Run("rejects invalid hours", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("unexpected request")
+		})
+
+		_, err := client.UpdateBusinessHours(context.Background(), "12", BusinessHours{Sun: DayHours{StartTime: 1700, EndTime: 900}})
+		if err == nil || !strings.HasPrefix(err.Error(), "Sunday:") {
+			t.Errorf("unexpected error %v", err)
+		}
+	})
+}
+
+func TestMutateLocation(t *testing.T) {
+	hours := BusinessHours{Mon: DayHours{StartTime: 800, EndTime: 1200}}
+
+	// The server keeps the location and answers GETs slowly, so that
+	// concurrent read-modify-write cycles overlap unless serialized.
+	var (
+		mu     sync.Mutex
+		stored = fixtureValue[Location](t, "location.json")
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			content, _ := json.Marshal(stored)
+			mu.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			respond(http.StatusOK, content)(w, r)
+		case http.MethodPut:
+			var location Location
+			if err := json.NewDecoder(r.Body).Decode(&location); err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			stored = location
+			mu.Unlock()
+			content, _ := json.Marshal(location)
+			respond(http.StatusOK, content)(w, r)
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := client.UpdateBusinessHours(context.Background(), "12", hours); err != nil {
+			t.Error(err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		_, err := client.MutateLocation(context.Background(), "12", func(l *Location) error {
+			l.Name = "Renamed"
+			return nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+	wg.Wait()
+
+	if stored.Name != "Renamed" || stored.BusinessHours != hours {
+		t.Errorf("lost an update: name %q, hours %+v", stored.Name, stored.BusinessHours)
+	}
+	if len(client.locationMu.locks) != 0 {
+		t.Errorf("%d location locks left", len(client.locationMu.locks))
+	}
+}
+
+func TestMutateResource(t *testing.T) {
+	availability := BusinessHours{Tue: DayHours{StartTime: 1300, EndTime: 1700}}
+
+	// The server keeps the resource and answers GETs slowly, so that
+	// concurrent read-modify-write cycles overlap unless serialized.
+	var (
+		mu     sync.Mutex
+		stored = fixtureValue[Resource](t, "resource.json")
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			content, _ := json.Marshal(stored)
+			mu.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			respond(http.StatusOK, content)(w, r)
+		case http.MethodPut:
+			var resource Resource
+			if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			stored = resource
+			mu.Unlock()
+			content, _ := json.Marshal(resource)
+			respond(http.StatusOK, content)(w, r)
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	})
+
+	id := stored.ID
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := client.UpdateResourceAvailability(context.Background(), id, availability); err != nil {
+			t.Error(err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		_, err := client.MutateResource(context.Background(), id, func(r *Resource) error {
+			r.Name = "Renamed"
+			return nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+	wg.Wait()
+
+	if stored.Name != "Renamed" || stored.Availability != availability {
+		t.Errorf("lost an update: name %q, availability %+v", stored.Name, stored.Availability)
+	}
+	if len(client.resourceMu.locks) != 0 {
+		t.Errorf("%d resource locks left", len(client.resourceMu.locks))
+	}
+}
+
+func TestDayHoursValidate(t *testing.T) {
+	tests := []struct {
+		hours   DayHours
+		wantErr bool
+	}{
+		{hours: DayHours{}},
+		{hours: DayHours{StartTime: 0, EndTime: 2359}},
+		{hours: DayHours{StartTime: 930, EndTime: 1730}},
+		{hours: DayHours{StartTime: 1730, EndTime: 930}, wantErr: true},
+		{hours: DayHours{StartTime: 900, EndTime: 900}, wantErr: true},
+		{hours: DayHours{StartTime: 960, EndTime: 1700}, wantErr: true},
+		{hours: DayHours{StartTime: 900, EndTime: 2400}, wantErr: true},
+		{hours: DayHours{StartTime: -100, EndTime: 900}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.hours.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%+v: err = %v, wantErr %v", tt.hours, err, tt.wantErr)
+		}
+	}
+}
+
+func TestUpdateResourceAvailability(t *testing.T) {
+	availability := BusinessHours{Tue: DayHours{StartTime: 1300, EndTime: 1700}}
+
+	var methods []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.URL.Path != "/setup/v1/resources/56" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			var resource Resource
+			if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+				t.Fatal(err)
+			}
+			want := fixtureValue[Resource](t, "resource.json")
+			want.Availability = availability
+			if !reflect.DeepEqual(resource, want) {
+				t.Errorf("saved %+v, want %+v", resource, want)
+			}
+			content, _ := json.Marshal(resource)
+			respond(http.StatusOK, content)(w, r)
+			return
+		}
+		respond(http.StatusOK, fixture(t, "resource.json"))(w, r)
+	})
+
+	got, err := client.UpdateResourceAvailability(context.Background(), "56", availability)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != availability {
+		t.Errorf("got %+v, want %+v", got, availability)
+	}
+	if !reflect.DeepEqual(methods, []string{"GET", "PUT"}) {
+		t.Errorf("requests = %q, want GET then PUT", methods)
+	}
+
+	_, err = client.UpdateResourceAvailability(context.Background(), "56", BusinessHours{Wed: DayHours{StartTime: 900, EndTime: 2400}})
+	if err == nil || !strings.HasPrefix(err.Error(), "Wednesday:") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestGetEffectiveAvailability(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/setup/v1/resources/56":
+			respond(http.StatusOK, fixture(t, "resource.json"))(w, r)
+		case "/setup/v1/locations/12":
+			respond(http.StatusOK, fixture(t, "location.json"))(w, r)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	got, err := client.GetEffectiveAvailability(context.Background(), "56")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := BusinessHours{
+		Mon: DayHours{StartTime: 900, EndTime: 1600},
+		Tue: DayHours{StartTime: 900, EndTime: 1600},
+		Thu: DayHours{StartTime: 1200, EndTime: 2000},
+		Fri: DayHours{StartTime: 900, EndTime: 1600},
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDayHoursIntersect(t *testing.T) {
+	tests := []struct {
+		a, b, want DayHours
+	}{
+		{a: DayHours{StartTime: 900, EndTime: 1700}, b: DayHours{StartTime: 800, EndTime: 1200}, want: DayHours{StartTime: 900, EndTime: 1200}},
+		{a: DayHours{StartTime: 900, EndTime: 1700}, b: DayHours{StartTime: 1000, EndTime: 1100}, want: DayHours{StartTime: 1000, EndTime: 1100}},
+		{a: DayHours{StartTime: 900, EndTime: 1200}, b: DayHours{StartTime: 1200, EndTime: 1700}},
+		{a: DayHours{StartTime: 900, EndTime: 1700}, b: DayHours{}},
+		{a: DayHours{}, b: DayHours{}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Intersect(tt.b); got != tt.want {
+			t.Errorf("%+v.Intersect(%+v) = %+v, want %+v", tt.a, tt.b, got, tt.want)
+		}
+		if got := tt.b.Intersect(tt.a); got != tt.want {
+			t.Errorf("%+v.Intersect(%+v) = %+v, want %+v", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestListPages(t *testing.T) {
+	const total = 2*listPageSize + 1
+
+	var offsets []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		offsets = append(offsets, query.Get("offset"))
+		if got := query.Get("limit"); got != "100" {
+			t.Errorf("limit = %s", got)
+		}
+
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		page := listResponse[Holiday]{Object: "list", Total: total}
+		for i := offset; i < total && len(page.Data) < listPageSize; i++ {
+			page.Data = append(page.Data, Holiday{ID: strconv.Itoa(i)})
+		}
+		page.Count = len(page.Data)
+		content, _ := json.Marshal(page)
+		respond(http.StatusOK, content)(w, r)
+	})
+
+	holidays, err := client.ListHolidays(context.Background(), "12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holidays) != total || holidays[total-1].ID != strconv.Itoa(total-1) {
+		t.Errorf("got %d holidays", len(holidays))
+	}
+	if !reflect.DeepEqual(offsets, []string{"0", "100", "200"}) {
+		t.Errorf("offsets = %q", offsets)
+	}
+}
@@ -0,0 +1,127 @@
+package onsched
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// APIError is returned when the OnSched API responds with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	RequestID  string
+	Code       string
+	Message    string
+	Body       []byte
+}
+
+// ConflictError is returned by Client.MutateCompany when the company was
+// modified by someone else since the caller last read it.
+type ConflictError struct {
+	// Fields lists the JSON names of the fields that changed.
+	Fields []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("onsched: company was modified since it was last read (changed: %s)", strings.Join(e.Fields, ", "))
+}
+
+// errorPayload is the error body returned by the OnSched API.
+type errorPayload struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "onsched: %s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Code != "" {
+		fmt.Fprintf(&b, " (%s)", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " [request id %s]", e.RequestID)
+	}
+	return b.String()
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.Path = resp.Request.URL.Path
+	}
+	e.RequestID = resp.Header.Get("X-Request-Id")
+	if e.RequestID == "" {
+		e.RequestID = resp.Header.Get("Request-Id")
+	}
+
+	var payload errorPayload
+	if json.Unmarshal(body, &payload) == nil {
+		e.Code = payload.Code
+		e.Message = payload.Message
+		if e.Message == "" {
+			e.Message = payload.Error
+		}
+	} else {
+		e.Message = strings.TrimSpace(string(body))
+	}
+	return e
+}
+
+func statusOf(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	var tokenErr *oauth2.RetrieveError
+	if errors.As(err, &tokenErr) && tokenErr.Response != nil {
+		// The identity server rejects bad client credentials with 400
+		// invalid_client rather than 401.
+		if tokenErr.ErrorCode == "invalid_client" {
+			return http.StatusUnauthorized
+		}
+		return tokenErr.Response.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an API error with status 404.
+func IsNotFound(err error) bool {
+	return statusOf(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is caused by missing or invalid
+// credentials, either from the API or from the token endpoint.
+func IsUnauthorized(err error) bool {
+	return statusOf(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an API error with status 403.
+func IsForbidden(err error) bool {
+	return statusOf(err) == http.StatusForbidden
+}
+
+// IsRateLimited reports whether err is an API error with status 429.
+func IsRateLimited(err error) bool {
+	return statusOf(err) == http.StatusTooManyRequests
+}
+
+// IsConflict reports whether err is a *ConflictError or an API error with
+// status 409.
+func IsConflict(err error) bool {
+	var conflict *ConflictError
+	return errors.As(err, &conflict) || statusOf(err) == http.StatusConflict
+}
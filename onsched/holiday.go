@@ -0,0 +1,27 @@
+package onsched
+
+// Holiday is a day on which a location is closed, either entirely or for part
+// of the day.
+type Holiday struct {
+	Object     string `json:"object"`
+	ID         string `json:"id"`
+	LocationID string `json:"locationId"`
+	Name       string `json:"name"`
+	// Date is the day of the closure in YYYY-MM-DD form.
+	Date string `json:"date"`
+	// StartTime and EndTime limit the closure to part of the day, in the
+	// HHMM form used by DayHours. Both are zero for a full-day closure.
+	StartTime int  `json:"startTime"`
+	EndTime   int  `json:"endTime"`
+	Deleted   bool `json:"deleted"`
+}
+
+// ClosedHours returns the part of the day the location is closed.
+func (h Holiday) ClosedHours() DayHours {
+	return DayHours{StartTime: h.StartTime, EndTime: h.EndTime}
+}
+
+// FullDay reports whether the location is closed for the whole day.
+func (h Holiday) FullDay() bool {
+	return h.ClosedHours().Closed()
+}
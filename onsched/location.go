@@ -0,0 +1,119 @@
+package onsched
+
+import (
+	"fmt"
+	"time"
+)
+
+type Location struct {
+	Object        string           `json:"object"`
+	ID            string           `json:"id"`
+	Name          string           `json:"name"`
+	FriendlyID    string           `json:"friendlyId"`
+	TimezoneID    string           `json:"timezoneId"`
+	TimezoneName  string           `json:"timezoneName"`
+	Phone         string           `json:"phone"`
+	Email         string           `json:"email"`
+	Website       string           `json:"website"`
+	Address       Address          `json:"address"`
+	Settings      LocationSettings `json:"settings"`
+	BusinessHours BusinessHours    `json:"businessHours"`
+	Deleted       bool             `json:"deleted"`
+}
+
+type Address struct {
+	AddressLine1 string `json:"addressLine1"`
+	AddressLine2 string `json:"addressLine2"`
+	City         string `json:"city"`
+	State        string `json:"state"`
+	PostalCode   string `json:"postalCode"`
+	Country      string `json:"country"`
+}
+
+type LocationSettings struct {
+	EnableEmailNotifications bool `json:"enableEmailNotifications"`
+	EnableSmsNotifications   bool `json:"enableSmsNotifications"`
+}
+
+// BusinessHours holds the opening hours of a location per weekday.
+type BusinessHours struct {
+	Mon DayHours `json:"mon"`
+	Tue DayHours `json:"tue"`
+	Wed DayHours `json:"wed"`
+	Thu DayHours `json:"thu"`
+	Fri DayHours `json:"fri"`
+	Sat DayHours `json:"sat"`
+	Sun DayHours `json:"sun"`
+}
+
+// Days returns the hours of each weekday, starting on Monday.
+func (h *BusinessHours) Days() []*DayHours {
+	return []*DayHours{&h.Mon, &h.Tue, &h.Wed, &h.Thu, &h.Fri, &h.Sat, &h.Sun}
+}
+
+// Validate reports the first invalid day, prefixed with its weekday.
+func (h *BusinessHours) Validate() error {
+	for i, day := range h.Days() {
+		if err := day.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", time.Weekday((i+1)%7), err)
+		}
+	}
+	return nil
+}
+
+// Intersect returns the hours during which both h and other are open.
+func (h BusinessHours) Intersect(other BusinessHours) BusinessHours {
+	var hours BusinessHours
+	for i, day := range hours.Days() {
+		*day = h.Days()[i].Intersect(*other.Days()[i])
+	}
+	return hours
+}
+
+// DayHours is an opening interval expressed as 24-hour clock times in HHMM
+// form, e.g. 930 for 09:30. A day with both values zero is closed.
+type DayHours struct {
+	StartTime int `json:"startTime"`
+	EndTime   int `json:"endTime"`
+}
+
+// Closed reports whether no opening interval is set.
+func (d DayHours) Closed() bool {
+	return d.StartTime == 0 && d.EndTime == 0
+}
+
+// Validate reports whether d is either closed or a valid interval that starts
+// before it ends.
+func (d DayHours) Validate() error {
+	if d.Closed() {
+		return nil
+	}
+	for _, t := range []int{d.StartTime, d.EndTime} {
+		if t < 0 || t > 2359 || t%100 > 59 {
+			return fmt.Errorf("invalid time %04d", t)
+		}
+	}
+	if d.StartTime >= d.EndTime {
+		return fmt.Errorf("start time %04d is not before end time %04d", d.StartTime, d.EndTime)
+	}
+	return nil
+}
+
+// Intersect returns the interval during which both d and other are open,
+// which is closed when they don't overlap.
+func (d DayHours) Intersect(other DayHours) DayHours {
+	if d.Closed() || other.Closed() {
+		return DayHours{}
+	}
+	start, end := d.StartTime, d.EndTime
+	if other.StartTime > start {
+		start = other.StartTime
+	}
+	if other.EndTime < end {
+		end = other.EndTime
+	}
+	if start >= end {
+		return DayHours{}
+	}
+	return DayHours{StartTime: start, EndTime: end}
+}
@@ -0,0 +1,36 @@
+package onsched
+
+// NotificationType selects the channels a resource is notified through.
+type NotificationType int
+
+const (
+	NotificationNone NotificationType = iota
+	NotificationEmail
+	NotificationSms
+	NotificationEmailAndSms
+)
+
+// Resource is a bookable staff member, room or piece of equipment.
+type Resource struct {
+	Object              string           `json:"object"`
+	ID                  string           `json:"id"`
+	LocationID          string           `json:"locationId"`
+	Name                string           `json:"name"`
+	Description         string           `json:"description"`
+	Email               string           `json:"email"`
+	Phone               string           `json:"businessPhone"`
+	MobilePhone         string           `json:"mobilePhone"`
+	TimezoneName        string           `json:"timezoneName"`
+	NotificationType    NotificationType `json:"notificationType"`
+	BookingNotification bool             `json:"bookingNotification"`
+	// Availability is the weekly schedule during which the resource can be
+	// booked, limited further by the business hours of its location.
+	Availability BusinessHours `json:"availability"`
+	Deleted      bool          `json:"deleted"`
+}
+
+// resourceLink is the request body linking a resource to another object,
+// such as a resource group or a service.
+type resourceLink struct {
+	ResourceID string `json:"resourceId"`
+}
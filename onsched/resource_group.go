@@ -0,0 +1,10 @@
+package onsched
+
+// ResourceGroup groups resources, e.g. staff by team.
+type ResourceGroup struct {
+	Object      string `json:"object"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Deleted     bool   `json:"deleted"`
+}
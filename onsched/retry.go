@@ -0,0 +1,122 @@
+package onsched
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how requests are retried after transient failures
+// such as rate limiting or an unavailable upstream.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the initial attempt. Zero
+	// disables retries.
+	MaxRetries int
+	// MinWait is the backoff before the first retry. It doubles with each
+	// subsequent attempt.
+	MinWait time.Duration
+	// MaxWait caps both the computed backoff and any Retry-After delay
+	// requested by the server.
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinWait:    1 * time.Second,
+		MaxWait:    30 * time.Second,
+	}
+}
+
+// WithRetryPolicy overrides the retry policy of the client.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// isIdempotent reports whether requests using method may be safely repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status indicates a transient
+// failure worth retrying.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before retry number attempt (starting at
+// zero), preferring the server's Retry-After header when present.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return p.clamp(wait)
+		}
+	}
+
+	wait := p.MinWait
+	for i := 0; i < attempt && wait < p.MaxWait; i++ {
+		wait *= 2
+	}
+	wait = p.clamp(wait)
+	if wait <= 0 {
+		return 0
+	}
+	// Jitter the second half of the interval so parallel Terraform
+	// operations don't retry in lockstep.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func (p RetryPolicy) clamp(wait time.Duration) time.Duration {
+	if p.MaxWait > 0 && wait > p.MaxWait {
+		return p.MaxWait
+	}
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an
+// HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at), true
+	}
+	return 0, false
+}
+
+// rewind prepares req to be sent again by resetting its body.
+func rewind(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	if req.GetBody == nil {
+		return errors.New("onsched: request body cannot be replayed")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
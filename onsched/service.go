@@ -0,0 +1,21 @@
+package onsched
+
+type Service struct {
+	Object           string      `json:"object"`
+	ID               string      `json:"id"`
+	LocationID       string      `json:"locationId"`
+	ServiceGroupID   string      `json:"serviceGroupId"`
+	Name             string      `json:"name"`
+	Description      string      `json:"description"`
+	Duration         int         `json:"duration"`
+	DurationInterval int         `json:"durationInterval"`
+	BookingLimit     int         `json:"bookingLimit"`
+	Fees             ServiceFees `json:"fees"`
+	Deleted          bool        `json:"deleted"`
+}
+
+type ServiceFees struct {
+	FeeAmount     float64 `json:"feeAmount"`
+	FeeTaxable    bool    `json:"feeTaxable"`
+	DepositAmount float64 `json:"depositAmount"`
+}
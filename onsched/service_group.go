@@ -0,0 +1,12 @@
+package onsched
+
+// ServiceGroup organises services on the booking pages.
+type ServiceGroup struct {
+	Object      string `json:"object"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// SortKey orders the groups on the booking pages, lowest first.
+	SortKey int  `json:"sortKey"`
+	Deleted bool `json:"deleted"`
+}
@@ -2,53 +2,183 @@ package onsched
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
 )
 
 func (c *Client) buildEndpoint(path string) string {
 	return fmt.Sprintf("%s/%s", c.apiHost, path)
 }
 
-func (c *Client) get(path string) ([]byte, error) {
-	resp, err := c.http.Get(c.buildEndpoint(path))
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.buildEndpoint(path), nil)
 	if err != nil {
 		return nil, err
 	}
-	return readResponse(resp)
+	return c.do(req)
+}
+
+// listPageSize is the number of items requested per page by list.
+const listPageSize = 100
+
+// listResponse is a page of a collection returned by the API.
+type listResponse[T any] struct {
+	Object string `json:"object"`
+	Count  int    `json:"count"`
+	Total  int    `json:"total"`
+	Data   []T    `json:"data"`
+}
+
+// list fetches every page of the collection at path.
+func list[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	items := []T{}
+	for {
+		query := url.Values{}
+		query.Set("offset", strconv.Itoa(len(items)))
+		query.Set("limit", strconv.Itoa(listPageSize))
+
+		result, err := c.get(ctx, path+"?"+query.Encode())
+		if err != nil {
+			return nil, err
+		}
+		page, err := parse[listResponse[T]](result)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Data...)
+		if len(page.Data) == 0 || len(items) >= page.Total {
+			return items, nil
+		}
+	}
 }
 
-func (c *Client) put(path string, data any) ([]byte, error) {
-	req, err := newJsonRequest("PUT", c.buildEndpoint(path), data)
-	req.Header.Add("content-type", "application/json")
+func (c *Client) put(ctx context.Context, path string, data any) ([]byte, error) {
+	req, err := newJsonRequest(ctx, "PUT", c.buildEndpoint(path), data)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.http.Do(req)
+	return c.do(req)
+}
+
+// do sends req and reads the response. Transient failures of idempotent
+// requests are retried according to the client's retry policy; other
+// requests, such as POSTs creating objects, are sent only once.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	attempts := 1
+	if isIdempotent(req.Method) {
+		attempts += c.retry.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := rewind(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.send(req)
+		if err == nil {
+			var content []byte
+			content, err = readResponse(resp)
+			if err == nil {
+				return content, nil
+			}
+		}
+
+		if attempt+1 >= attempts || !shouldRetry(req, err) {
+			return nil, err
+		}
+
+		timer := time.NewTimer(c.retry.backoff(attempt, resp))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// send authenticates req with the client's access token and sends it.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	token, err := c.accessToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	token.SetAuthHeader(req)
+	return c.http.Do(req)
+}
+
+func shouldRetry(req *http.Request, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return isRetryableStatus(apiErr.StatusCode)
+	}
+
+	var tokenErr *oauth2.RetrieveError
+	if errors.As(err, &tokenErr) {
+		return tokenErr.Response != nil && isRetryableStatus(tokenErr.Response.StatusCode)
+	}
+
+	// Anything else is a transport level failure.
+	return true
+}
+
+func (c *Client) post(ctx context.Context, path string, data any) ([]byte, error) {
+	req, err := newJsonRequest(ctx, "POST", c.buildEndpoint(path), data)
 	if err != nil {
 		return nil, err
 	}
 
-	return readResponse(resp)
+	return c.do(req)
+}
+
+func (c *Client) delete(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", c.buildEndpoint(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
 }
 
-func newJsonRequest(method, path string, data any) (*http.Request, error) {
-	req, err := http.NewRequest(method, path, reader(data))
+func newJsonRequest(ctx context.Context, method, path string, data any) (*http.Request, error) {
+	body, err := reader(data)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("content-type", "application/json")
+	req, err := http.NewRequestWithContext(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
 	return req, nil
 }
 
 func readResponse(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
 	content, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newAPIError(resp, content)
+	}
 	return content, nil
 }
 
@@ -56,13 +186,55 @@ func parse[T any](content []byte) (T, error) {
 	result := new(T)
 	err := json.Unmarshal(content, result)
 	if err != nil {
-		return *result, err
+		return *result, fmt.Errorf("decoding response: %w", err)
 	}
 	return *result, nil
 }
 
-func reader(data any) io.Reader {
-	content, _ := json.Marshal(data)
-	buffer := bytes.NewBuffer(content)
-	return buffer
+func reader(data any) (io.Reader, error) {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("encoding request body: %w", err)
+	}
+	return bytes.NewReader(content), nil
+}
+
+// keyedMutex serializes operations per key, such as the read-modify-write
+// cycle of the object with a given ID. The zero value is ready to use.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	// waiters counts the holder and the goroutines waiting for the lock,
+	// which is dropped from the map once none are left.
+	waiters int
+}
+
+// lock locks key and returns the function unlocking it.
+func (m *keyedMutex) lock(key string) (unlock func()) {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*keyedLock{}
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		m.locks[key] = l
+	}
+	l.waiters++
+	m.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if l.waiters--; l.waiters == 0 {
+			delete(m.locks, key)
+		}
+	}
 }
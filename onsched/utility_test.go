@@ -0,0 +1,217 @@
+package onsched
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    Service
+		wantErr bool
+	}{
+		{
+			name:    "object",
+			content: `{"id":"34","duration":60,"fees":{"feeAmount":9.5}}`,
+			want:    Service{ID: "34", Duration: 60, Fees: ServiceFees{FeeAmount: 9.5}},
+		},
+		{
+			name:    "unknown fields",
+			content: `{"id":"34","unknown":true}`,
+			want:    Service{ID: "34"},
+		},
+		{
+			name:    "empty",
+			content: ``,
+			wantErr: true,
+		},
+		{
+			name:    "wrong type",
+			content: `{"id":34}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse[Service]([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    any
+		want    string
+		wantErr bool
+	}{
+		{name: "struct", data: DayHours{StartTime: 900, EndTime: 1700}, want: `{"startTime":900,"endTime":1700}`},
+		{name: "nil", data: nil, want: `null`},
+		{name: "unsupported type", data: make(chan int), wantErr: true},
+		{name: "unsupported value", data: math.Inf(1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := reader(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			content, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != tt.want {
+				t.Errorf("got %s, want %s", content, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewJsonRequest(t *testing.T) {
+	req, err := newJsonRequest(context.Background(), "POST", "https://api.example/setup/v1/services", Service{ID: "34"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := req.Header.Values("Content-Type"); !reflect.DeepEqual(got, []string{"application/json"}) {
+		t.Errorf("Content-Type = %q", got)
+	}
+	// The body must be replayable for retries.
+	if req.GetBody == nil {
+		t.Fatal("GetBody is nil")
+	}
+	if err := rewind(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := newJsonRequest(context.Background(), "POST", "https://api.example", make(chan int)); err == nil {
+		t.Error("expected an encoding error")
+	}
+	if _, err := newJsonRequest(context.Background(), "POST", "://bad url", nil); err == nil {
+		t.Error("expected an invalid URL error")
+	}
+}
+
+func TestPutEncodingError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request", r.Method)
+	})
+
+	tests := []struct {
+		name string
+		send func(context.Context, string, any) ([]byte, error)
+	}{
+		{name: "put", send: client.put},
+		{name: "post", send: client.post},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := tt.send(context.Background(), "setup/v1/companies", make(chan int))
+			var unsupported *json.UnsupportedTypeError
+			if !errors.As(err, &unsupported) {
+				t.Fatalf("expected *json.UnsupportedTypeError, got %v", err)
+			}
+			if body != nil {
+				t.Errorf("body = %s", body)
+			}
+		})
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	policy := WithRetryPolicy(RetryPolicy{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond})
+	company := fixture(t, "company.json")
+
+	tests := []struct {
+		name     string
+		call     func(context.Context, *Client) error
+		statuses []int
+		wantErr  bool
+		// requests is the expected number of API requests.
+		requests int
+	}{
+		{
+			name:     "GET retried until success",
+			call:     func(ctx context.Context, c *Client) error { _, err := c.GetCompany(ctx); return err },
+			statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			requests: 3,
+		},
+		{
+			name:     "GET gives up after max retries",
+			call:     func(ctx context.Context, c *Client) error { _, err := c.GetCompany(ctx); return err },
+			statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			wantErr:  true,
+			requests: 3,
+		},
+		{
+			name: "PUT retried with the same body",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.UpdateCompany(ctx, Company{ID: "x"})
+				return err
+			},
+			statuses: []int{http.StatusGatewayTimeout, http.StatusOK},
+			requests: 2,
+		},
+		{
+			name:     "POST not retried",
+			call:     func(ctx context.Context, c *Client) error { _, err := c.CreateService(ctx, Service{}); return err },
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantErr:  true,
+			requests: 1,
+		},
+		{
+			name:     "client errors not retried",
+			call:     func(ctx context.Context, c *Client) error { _, err := c.GetCompany(ctx); return err },
+			statuses: []int{http.StatusBadRequest, http.StatusOK},
+			wantErr:  true,
+			requests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			var bodies []string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				status := tt.statuses[requests]
+				requests++
+				respond(status, company)(w, r)
+			}, policy)
+
+			err := tt.call(context.Background(), client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if requests != tt.requests {
+				t.Errorf("sent %d requests, want %d", requests, tt.requests)
+			}
+			for _, body := range bodies[1:] {
+				if body != bodies[0] {
+					t.Errorf("retried with body %q, want %q", body, bodies[0])
+				}
+			}
+		})
+	}
+}
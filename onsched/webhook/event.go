@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Kind identifies which webhook an event was sent to.
+type Kind string
+
+const (
+	KindBooking  Kind = "booking"
+	KindCustomer Kind = "customer"
+	KindResource Kind = "resource"
+	KindReminder Kind = "reminder"
+)
+
+// objectKinds maps the object field of a payload to its kind.
+var objectKinds = map[string]Kind{
+	"appointment": KindBooking,
+	"customer":    KindCustomer,
+	"resource":    KindResource,
+	"reminder":    KindReminder,
+}
+
+// Event is a parsed webhook payload. Exactly one of the typed payloads is
+// set, matching Kind.
+type Event struct {
+	Kind     Kind
+	Booking  *BookingEvent
+	Customer *CustomerEvent
+	Resource *ResourceEvent
+	Reminder *ReminderEvent
+	// Raw is the unparsed payload.
+	Raw json.RawMessage
+}
+
+// BookingEvent is sent to the booking webhook when an appointment is
+// booked, rescheduled or cancelled.
+type BookingEvent struct {
+	Object        string `json:"object"`
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	LocationID    string `json:"locationId"`
+	ServiceID     string `json:"serviceId"`
+	ResourceID    string `json:"resourceId"`
+	CustomerID    string `json:"customerId"`
+	StartDateTime string `json:"startDateTime"`
+	EndDateTime   string `json:"endDateTime"`
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+}
+
+// CustomerEvent is sent to the customer webhook when a customer is created
+// or changed.
+type CustomerEvent struct {
+	Object     string `json:"object"`
+	ID         string `json:"id"`
+	LocationID string `json:"locationId"`
+	FirstName  string `json:"firstname"`
+	LastName   string `json:"lastname"`
+	Email      string `json:"email"`
+	Phone      string `json:"phone"`
+}
+
+// ResourceEvent is sent to the resource webhook when a resource is created
+// or changed.
+type ResourceEvent struct {
+	Object     string `json:"object"`
+	ID         string `json:"id"`
+	LocationID string `json:"locationId"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+}
+
+// ReminderEvent is sent to the reminder webhook when an appointment reminder
+// is due.
+type ReminderEvent struct {
+	Object        string `json:"object"`
+	ID            string `json:"id"`
+	AppointmentID string `json:"appointmentId"`
+	ReminderType  string `json:"reminderType"`
+	ScheduledTime string `json:"scheduledTime"`
+}
+
+// Parse decodes a webhook payload into its typed event.
+func Parse(body []byte) (Event, error) {
+	var header struct {
+		Object string `json:"object"`
+	}
+	if err := json.Unmarshal(body, &header); err != nil {
+		return Event{}, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+
+	event := Event{Kind: objectKinds[header.Object], Raw: body}
+
+	var target any
+	switch event.Kind {
+	case KindBooking:
+		event.Booking = &BookingEvent{}
+		target = event.Booking
+	case KindCustomer:
+		event.Customer = &CustomerEvent{}
+		target = event.Customer
+	case KindResource:
+		event.Resource = &ResourceEvent{}
+		target = event.Resource
+	case KindReminder:
+		event.Reminder = &ReminderEvent{}
+		target = event.Reminder
+	default:
+		return Event{}, fmt.Errorf("webhook: unknown payload object %q", header.Object)
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return Event{}, fmt.Errorf("webhook: invalid %s payload: %w", event.Kind, err)
+	}
+	return event, nil
+}
@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want Event
+	}{
+		{
+			name: "booking",
+			body: `{"object":"appointment","id":"apt_1","status":"BK","locationId":"12","serviceId":"34","resourceId":"56","customerId":"78","startDateTime":"2023-11-14T09:00:00-05:00","endDateTime":"2023-11-14T09:30:00-05:00","name":"Jane Doe","email":"jane@example.com"}`,
+			want: Event{Kind: KindBooking, Booking: &BookingEvent{
+				Object:        "appointment",
+				ID:            "apt_1",
+				Status:        "BK",
+				LocationID:    "12",
+				ServiceID:     "34",
+				ResourceID:    "56",
+				CustomerID:    "78",
+				StartDateTime: "2023-11-14T09:00:00-05:00",
+				EndDateTime:   "2023-11-14T09:30:00-05:00",
+				Name:          "Jane Doe",
+				Email:         "jane@example.com",
+			}},
+		},
+		{
+			name: "customer",
+			body: `{"object":"customer","id":"78","locationId":"12","firstname":"Jane","lastname":"Doe","email":"jane@example.com","phone":"6135550100"}`,
+			want: Event{Kind: KindCustomer, Customer: &CustomerEvent{
+				Object:     "customer",
+				ID:         "78",
+				LocationID: "12",
+				FirstName:  "Jane",
+				LastName:   "Doe",
+				Email:      "jane@example.com",
+				Phone:      "6135550100",
+			}},
+		},
+		{
+			name: "resource",
+			body: `{"object":"resource","id":"56","locationId":"12","name":"Room 1","email":"room1@example.com"}`,
+			want: Event{Kind: KindResource, Resource: &ResourceEvent{
+				Object:     "resource",
+				ID:         "56",
+				LocationID: "12",
+				Name:       "Room 1",
+				Email:      "room1@example.com",
+			}},
+		},
+		{
+			name: "reminder",
+			body: `{"object":"reminder","id":"rem_1","appointmentId":"apt_1","reminderType":"email","scheduledTime":"2023-11-13T09:00:00-05:00"}`,
+			want: Event{Kind: KindReminder, Reminder: &ReminderEvent{
+				Object:        "reminder",
+				ID:            "rem_1",
+				AppointmentID: "apt_1",
+				ReminderType:  "email",
+				ScheduledTime: "2023-11-13T09:00:00-05:00",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := Parse([]byte(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(event.Raw) != tt.body {
+				t.Errorf("Raw = %s", event.Raw)
+			}
+			event.Raw = nil
+			if !reflect.DeepEqual(event, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", event, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "not json", body: `<xml/>`, want: "invalid payload"},
+		{name: "unknown object", body: `{"object":"invoice"}`, want: `unknown payload object "invoice"`},
+		{name: "missing object", body: `{"id":"apt_1"}`, want: `unknown payload object ""`},
+		{name: "mistyped field", body: `{"object":"appointment","id":1}`, want: "invalid booking payload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.body))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Package webhook verifies and parses the webhooks OnSched sends to the URLs
+// configured with the onsched_webhook resource.
+//
+// OnSched signs webhooks with the company's webhook signature hash, but its
+// public API documentation doesn't describe the headers or the signed
+// message, and this package hasn't been checked against a recorded OnSched
+// request. By default a Verifier expects the X-OnSched-Signature header to
+// hold the hex encoded HMAC-SHA256, keyed with the secret, of the
+// X-OnSched-Timestamp header value (unix seconds), a period and the raw
+// request body. Compare this with a request received from OnSched before
+// relying on it, and use WithHeaders and WithoutTimestamp to match requests
+// that are signed differently.
+//
+// For example, with the secret "whsec_5FZbXhLq", the timestamp 1700000000 and
+// the body
+//
+//	{"object":"appointment","id":"apt_1","status":"BK"}
+//
+// the signed message is
+//
+//	1700000000.{"object":"appointment","id":"apt_1","status":"BK"}
+//
+// and the signature
+//
+//	7c9895537c759816e085d924296d5ecc8e72039b0a75e7faa9e8e5a8959397c2
+//
+// which can be reproduced with
+//
+//	printf '%s' "$message" | openssl dgst -sha256 -hmac "$secret"
+//
+// Without a timestamp, the signed message is the body alone.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// SignatureHeader and TimestampHeader are the headers a Verifier reads
+	// unless configured WithHeaders.
+	SignatureHeader = "X-OnSched-Signature"
+	TimestampHeader = "X-OnSched-Timestamp"
+
+	// DefaultTolerance is the maximum age of a request accepted by a
+	// Verifier without an explicit tolerance.
+	DefaultTolerance = 5 * time.Minute
+
+	// MaxBodySize is the size of the largest webhook body accepted by a
+	// Verifier.
+	MaxBodySize = 1 << 20
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook: missing signature or timestamp")
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrExpired          = errors.New("webhook: timestamp outside of tolerance")
+	ErrReplayed         = errors.New("webhook: request was already received")
+	ErrBodyTooLarge     = errors.New("webhook: body exceeds the maximum size")
+)
+
+// Verifier checks the signature of incoming webhook requests and rejects
+// requests that are too old or were already received.
+type Verifier struct {
+	secret          string
+	signatureHeader string
+	timestampHeader string
+	tolerance       time.Duration
+	now             func() time.Time
+
+	mu sync.Mutex
+	// seen holds the time each accepted signature was received at. Expired
+	// signatures are pruned at most once per tolerance, see remember.
+	seen      map[string]time.Time
+	nextPrune time.Time
+}
+
+// Option configures a Verifier.
+type Option func(*Verifier)
+
+// WithTolerance sets how far the request timestamp may be from the current
+// time. Signatures are remembered for this long to detect replays.
+func WithTolerance(tolerance time.Duration) Option {
+	return func(v *Verifier) {
+		v.tolerance = tolerance
+	}
+}
+
+// WithHeaders sets the names of the headers holding the signature and the
+// timestamp. An empty timestamp header is the same as WithoutTimestamp.
+func WithHeaders(signature, timestamp string) Option {
+	return func(v *Verifier) {
+		v.signatureHeader = signature
+		v.timestampHeader = timestamp
+	}
+}
+
+// WithoutTimestamp accepts requests signed without a timestamp, whose
+// signature covers the body alone. As their age can't be checked, replays of
+// such requests are only rejected within twice the tolerance of the first
+// delivery.
+func WithoutTimestamp() Option {
+	return func(v *Verifier) {
+		v.timestampHeader = ""
+	}
+}
+
+// WithClock replaces the clock used to check timestamps.
+func WithClock(now func() time.Time) Option {
+	return func(v *Verifier) {
+		v.now = now
+	}
+}
+
+// NewVerifier returns a Verifier for the given webhook signature hash.
+func NewVerifier(secret string, opts ...Option) *Verifier {
+	v := &Verifier{
+		secret:          secret,
+		signatureHeader: SignatureHeader,
+		timestampHeader: TimestampHeader,
+		tolerance:       DefaultTolerance,
+		now:             time.Now,
+		seen:            map[string]time.Time{},
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Sign returns the signature of body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignBody returns the signature of body sent without a timestamp.
+func SignBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reads the body of r and checks its signature, timestamp and that it
+// was not received before. The body is returned and r.Body is replaced so it
+// can be read again. Bodies larger than MaxBodySize are rejected with
+// ErrBodyTooLarge.
+func (v *Verifier) Verify(r *http.Request) ([]byte, error) {
+	signature := r.Header.Get(v.signatureHeader)
+	if signature == "" {
+		return nil, ErrMissingSignature
+	}
+
+	now := v.now()
+	var sent time.Time
+	if v.timestampHeader != "" {
+		timestamp := r.Header.Get(v.timestampHeader)
+		if timestamp == "" {
+			return nil, ErrMissingSignature
+		}
+
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidSignature
+		}
+		sent = time.Unix(seconds, 0)
+
+		if sent.Before(now.Add(-v.tolerance)) || sent.After(now.Add(v.tolerance)) {
+			return nil, ErrExpired
+		}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > MaxBodySize {
+		return nil, ErrBodyTooLarge
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	expected := SignBody(v.secret, body)
+	if v.timestampHeader != "" {
+		expected = Sign(v.secret, sent, body)
+	}
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, ErrInvalidSignature
+	}
+
+	if !v.remember(signature, now) {
+		return nil, ErrReplayed
+	}
+
+	return body, nil
+}
+
+// remember records signature as seen, returning false if it already was.
+func (v *Verifier) remember(signature string, now time.Time) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// A request may be timestamped up to the tolerance in the future, so its
+	// signature stays acceptable for up to twice the tolerance. Pruning only
+	// once per tolerance keeps the cost of a request constant while bounding
+	// the map to the signatures received in the last three tolerances.
+	if !now.Before(v.nextPrune) {
+		for s, at := range v.seen {
+			if now.Sub(at) > 2*v.tolerance {
+				delete(v.seen, s)
+			}
+		}
+		v.nextPrune = now.Add(v.tolerance)
+	}
+
+	if at, ok := v.seen[signature]; ok && now.Sub(at) <= 2*v.tolerance {
+		return false
+	}
+	v.seen[signature] = now
+	return true
+}
+
+type contextKey struct{}
+
+// Middleware verifies requests before passing them to next, responding with
+// 413 Request Entity Too Large to bodies over MaxBodySize, 401 Unauthorized to
+// requests that fail verification and 400 Bad Request to bodies that are not
+// a valid event. The parsed event is available to next
+// through EventFromContext.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := v.Verify(r)
+		if errors.Is(err, ErrBodyTooLarge) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		event, err := Parse(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey{}, event)))
+	})
+}
+
+// EventFromContext returns the event parsed by Middleware.
+func EventFromContext(ctx context.Context) (Event, bool) {
+	event, ok := ctx.Value(contextKey{}).(Event)
+	return event, ok
+}
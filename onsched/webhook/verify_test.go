@@ -0,0 +1,325 @@
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret = "whsec_5FZbXhLq"
+	testBody   = `{"object":"appointment","id":"apt_1","status":"BK"}`
+)
+
+var testTime = time.Unix(1700000000, 0)
+
+// newRequest returns a webhook request for body, signed at sent.
+func newRequest(body string, sent time.Time) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	r.Header.Set(TimestampHeader, strconv.FormatInt(sent.Unix(), 10))
+	r.Header.Set(SignatureHeader, Sign(testSecret, sent, []byte(body)))
+	return r
+}
+
+func newTestVerifier(now *time.Time) *Verifier {
+	return NewVerifier(testSecret, WithClock(func() time.Time { return *now }))
+}
+
+func TestSign(t *testing.T) {
+	// Computed independently with:
+	// printf '%s' '1700000000.{"object":"appointment","id":"apt_1","status":"BK"}' | openssl dgst -sha256 -hmac whsec_5FZbXhLq
+	want := "7c9895537c759816e085d924296d5ecc8e72039b0a75e7faa9e8e5a8959397c2"
+	if got := Sign(testSecret, testTime, []byte(testBody)); got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		request func() *http.Request
+		want    error
+	}{
+		{
+			name:    "valid signature",
+			request: func() *http.Request { return newRequest(testBody, testTime) },
+		},
+		{
+			name: "valid signature within tolerance",
+			request: func() *http.Request {
+				return newRequest(testBody, testTime.Add(-DefaultTolerance+time.Second))
+			},
+		},
+		{
+			name: "tampered body",
+			request: func() *http.Request {
+				r := newRequest(testBody, testTime)
+				r.Body = io.NopCloser(strings.NewReader(strings.Replace(testBody, "BK", "CN", 1)))
+				return r
+			},
+			want: ErrInvalidSignature,
+		},
+		{
+			name: "tampered timestamp",
+			request: func() *http.Request {
+				r := newRequest(testBody, testTime)
+				r.Header.Set(TimestampHeader, strconv.FormatInt(testTime.Unix()+1, 10))
+				return r
+			},
+			want: ErrInvalidSignature,
+		},
+		{
+			name: "malformed timestamp",
+			request: func() *http.Request {
+				r := newRequest(testBody, testTime)
+				r.Header.Set(TimestampHeader, "yesterday")
+				return r
+			},
+			want: ErrInvalidSignature,
+		},
+		{
+			name: "wrong secret",
+			request: func() *http.Request {
+				r := newRequest(testBody, testTime)
+				r.Header.Set(SignatureHeader, Sign("another secret", testTime, []byte(testBody)))
+				return r
+			},
+			want: ErrInvalidSignature,
+		},
+		{
+			name: "expired timestamp",
+			request: func() *http.Request {
+				return newRequest(testBody, testTime.Add(-DefaultTolerance-time.Second))
+			},
+			want: ErrExpired,
+		},
+		{
+			name: "future timestamp",
+			request: func() *http.Request {
+				return newRequest(testBody, testTime.Add(DefaultTolerance+time.Second))
+			},
+			want: ErrExpired,
+		},
+		{
+			name: "missing signature",
+			request: func() *http.Request {
+				r := newRequest(testBody, testTime)
+				r.Header.Del(SignatureHeader)
+				return r
+			},
+			want: ErrMissingSignature,
+		},
+		{
+			name: "missing timestamp",
+			request: func() *http.Request {
+				r := newRequest(testBody, testTime)
+				r.Header.Del(TimestampHeader)
+				return r
+			},
+			want: ErrMissingSignature,
+		},
+		{
+			name: "oversize body",
+			request: func() *http.Request {
+				return newRequest(strings.Repeat(" ", MaxBodySize+1), testTime)
+			},
+			want: ErrBodyTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := testTime
+			r := tt.request()
+
+			body, err := newTestVerifier(&now).Verify(r)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.want)
+			}
+			if tt.want != nil {
+				return
+			}
+			if string(body) != testBody {
+				t.Errorf("Verify() body = %s", body)
+			}
+			if again, _ := io.ReadAll(r.Body); string(again) != testBody {
+				t.Errorf("request body = %s", again)
+			}
+		})
+	}
+}
+
+func TestVerifyReplay(t *testing.T) {
+	now := testTime
+	v := newTestVerifier(&now)
+
+	if _, err := v.Verify(newRequest(testBody, testTime)); err != nil {
+		t.Fatal(err)
+	}
+
+	// The same delivery is rejected as long as its timestamp is acceptable.
+	now = testTime.Add(DefaultTolerance)
+	if _, err := v.Verify(newRequest(testBody, testTime)); !errors.Is(err, ErrReplayed) {
+		t.Errorf("replayed delivery: got %v, want %v", err, ErrReplayed)
+	}
+
+	// Other deliveries of the same body are accepted.
+	if _, err := v.Verify(newRequest(testBody, testTime.Add(time.Second))); err != nil {
+		t.Errorf("new delivery: %v", err)
+	}
+}
+
+func TestVerifyPrunesSeen(t *testing.T) {
+	now := testTime
+	v := newTestVerifier(&now)
+
+	for i := 0; i < 10; i++ {
+		if _, err := v.Verify(newRequest(testBody, now.Add(time.Duration(i)*time.Second))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(v.seen) != 10 {
+		t.Fatalf("remembered %d signatures, want 10", len(v.seen))
+	}
+
+	// Once the signatures can no longer be replayed they are forgotten on
+	// the next request.
+	now = now.Add(3 * DefaultTolerance)
+	if _, err := v.Verify(newRequest(testBody, now)); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.seen) != 1 {
+		t.Errorf("remembered %d signatures, want 1", len(v.seen))
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name    string
+		request func() *http.Request
+		status  int
+	}{
+		{
+			name:    "valid",
+			request: func() *http.Request { return newRequest(testBody, testTime) },
+			status:  http.StatusNoContent,
+		},
+		{
+			name: "invalid signature",
+			request: func() *http.Request {
+				r := newRequest(testBody, testTime)
+				r.Header.Set(SignatureHeader, "00")
+				return r
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "oversize body",
+			request: func() *http.Request {
+				return newRequest(strings.Repeat(" ", MaxBodySize+1), testTime)
+			},
+			status: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:    "unknown event",
+			request: func() *http.Request { return newRequest(`{"object":"invoice"}`, testTime) },
+			status:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := testTime
+			var (
+				event  Event
+				called bool
+			)
+			handler := newTestVerifier(&now).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				event, called = EventFromContext(r.Context())
+				w.WriteHeader(http.StatusNoContent)
+			}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, tt.request())
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.status == http.StatusNoContent && (event.Booking == nil || event.Booking.ID != "apt_1") {
+				t.Errorf("event = %+v", event)
+			}
+			if tt.status != http.StatusNoContent && called {
+				t.Errorf("next was called with %+v", event)
+			}
+		})
+	}
+}
+
+func TestSignBody(t *testing.T) {
+	// Computed independently with:
+	// printf '%s' '{"object":"appointment","id":"apt_1","status":"BK"}' | openssl dgst -sha256 -hmac whsec_5FZbXhLq
+	want := "db173611aaf36f8a71339e015ada17c62c079e5658341154b6a8edfec26f98de"
+	if got := SignBody(testSecret, []byte(testBody)); got != want {
+		t.Errorf("SignBody() = %s, want %s", got, want)
+	}
+}
+
+func TestVerifyOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		request func() *http.Request
+		want    error
+	}{
+		{
+			name: "custom headers",
+			opts: []Option{WithHeaders("X-Signature", "X-Timestamp")},
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(testBody))
+				r.Header.Set("X-Timestamp", strconv.FormatInt(testTime.Unix(), 10))
+				r.Header.Set("X-Signature", Sign(testSecret, testTime, []byte(testBody)))
+				return r
+			},
+		},
+		{
+			name:    "custom headers ignore the default ones",
+			opts:    []Option{WithHeaders("X-Signature", "X-Timestamp")},
+			request: func() *http.Request { return newRequest(testBody, testTime) },
+			want:    ErrMissingSignature,
+		},
+		{
+			name: "without timestamp",
+			opts: []Option{WithoutTimestamp()},
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(testBody))
+				r.Header.Set(SignatureHeader, SignBody(testSecret, []byte(testBody)))
+				return r
+			},
+		},
+		{
+			name:    "without timestamp rejects timestamped signatures",
+			opts:    []Option{WithoutTimestamp()},
+			request: func() *http.Request { return newRequest(testBody, testTime) },
+			want:    ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := testTime
+			opts := append([]Option{WithClock(func() time.Time { return now })}, tt.opts...)
+
+			body, err := NewVerifier(testSecret, opts...).Verify(tt.request())
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && string(body) != testBody {
+				t.Errorf("Verify() body = %s", body)
+			}
+		})
+	}
+}